@@ -12,6 +12,8 @@ import (
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
 
+	"github.com/cfioretti/calculator/internal/domain/flours"
+	"github.com/cfioretti/calculator/internal/infrastructure/catalog"
 	"github.com/cfioretti/calculator/internal/infrastructure/grpc/middleware"
 	httpHandlers "github.com/cfioretti/calculator/internal/infrastructure/http"
 	"github.com/cfioretti/calculator/internal/infrastructure/logging"
@@ -20,11 +22,14 @@ import (
 	"github.com/cfioretti/calculator/pkg/application"
 	grpcServer "github.com/cfioretti/calculator/pkg/infrastructure/grpc"
 	pb "github.com/cfioretti/calculator/pkg/infrastructure/grpc/proto/generated"
+	"github.com/cfioretti/calculator/pkg/shapes"
 )
 
 const (
 	defaultGRPCPort = ":50051"
 	defaultHTTPPort = ":8080"
+	defaultCatalog  = "configs/pan_catalog.json"
+	defaultFlours   = "configs/flours.json"
 	serviceName     = "calculator"
 	version         = "1.0.0"
 )
@@ -54,8 +59,15 @@ func main() {
 	httpPort := getHTTPPort()
 	logger.WithField("grpc_port", grpcPort).WithField("http_port", httpPort).Info("Server configuration loaded")
 
-	calculatorService := application.NewCalculatorService()
-	server := grpcServer.NewServer(calculatorService)
+	panCatalog := catalog.NewInMemoryPanCatalog()
+	catalogService := application.NewPanCatalogService(panCatalog, shapes.Default())
+	loadPanCatalog(ctx, catalogService)
+
+	calculatorService := application.NewCalculatorService(
+		application.WithPanCatalog(panCatalog),
+		application.WithFlourTable(loadFlourTable()),
+	)
+	server := grpcServer.NewServer(calculatorService, grpcServer.WithCatalogService(catalogService))
 
 	metricsMiddleware := middleware.NewMetricsMiddleware(prometheusMetrics, prometheusMetrics)
 
@@ -127,6 +139,56 @@ func getHTTPPort() string {
 	return fullPort
 }
 
+func getCatalogPath() string {
+	path := os.Getenv("PAN_CATALOG_PATH")
+	if path == "" {
+		return defaultCatalog
+	}
+	return path
+}
+
+// loadPanCatalog seeds the catalog with the presets file. Invalid presets are
+// skipped so a single bad entry does not keep the service from starting.
+func loadPanCatalog(ctx context.Context, catalogService *application.PanCatalogService) {
+	path := getCatalogPath()
+	pans, err := catalog.ReadPanCatalogFile(path)
+	if err != nil {
+		logger.WithError(err).WithField("path", path).Warn("Pan catalog not loaded, starting with an empty catalog")
+		return
+	}
+
+	loaded := 0
+	for _, pan := range pans {
+		if _, err := catalogService.CreatePan(ctx, pan); err != nil {
+			logger.WithError(err).WithField("pan_id", pan.ID).Warn("Skipping invalid catalog pan")
+			continue
+		}
+		loaded++
+	}
+	logger.WithField("path", path).WithField("pans", loaded).Info("Pan catalog loaded")
+}
+
+func getFlourTablePath() string {
+	path := os.Getenv("FLOUR_TABLE_PATH")
+	if path == "" {
+		return defaultFlours
+	}
+	return path
+}
+
+// loadFlourTable extends the built-in flours with the ones in the flour
+// table file, which replace built-in flours with the same ID.
+func loadFlourTable() *flours.Table {
+	path := getFlourTablePath()
+	extra, err := catalog.ReadFlourTableFile(path)
+	if err != nil {
+		logger.WithError(err).WithField("path", path).Warn("Flour table not loaded, using the built-in flours")
+		return flours.Default()
+	}
+	logger.WithField("path", path).WithField("flours", len(extra)).Info("Flour table loaded")
+	return flours.Default().With(extra...)
+}
+
 func setupHTTPServer(port string) *http.Server {
 	mux := http.NewServeMux()
 
@@ -0,0 +1,127 @@
+package flours
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/cfioretti/calculator/pkg/domain"
+)
+
+// BlendTolerance is how far from 100% the shares of a blend may sum, in
+// percentage points.
+const BlendTolerance = 0.01
+
+// Table is a read-only set of flours looked up by ID, ignoring case.
+type Table struct {
+	flours map[string]domain.Flour
+}
+
+var defaultTable = NewTable(
+	domain.Flour{ID: "tipo_00", Name: "Tipo 00", Absorption: 0},
+	domain.Flour{ID: "tipo_0", Name: "Tipo 0", Absorption: 2},
+	domain.Flour{ID: "tipo_1", Name: "Tipo 1", Absorption: 4},
+	domain.Flour{ID: "tipo_2", Name: "Tipo 2", Absorption: 7},
+	domain.Flour{ID: "whole_wheat", Name: "Whole wheat", Absorption: 10},
+	domain.Flour{ID: "manitoba", Name: "Manitoba", Absorption: 6},
+	domain.Flour{ID: "semola_rimacinata", Name: "Semola rimacinata", Absorption: 5},
+	domain.Flour{ID: "rye", Name: "Rye", Absorption: 12},
+	domain.Flour{ID: "spelt", Name: "Spelt", Absorption: -2},
+)
+
+// Default returns the built-in flours.
+func Default() *Table {
+	return defaultTable
+}
+
+func NewTable(flours ...domain.Flour) *Table {
+	t := &Table{flours: make(map[string]domain.Flour, len(flours))}
+	for _, flour := range flours {
+		t.flours[normalize(flour.ID)] = flour
+	}
+	return t
+}
+
+// With returns a copy of the table extended with the given flours; a flour
+// with the ID of an existing one replaces it.
+func (t *Table) With(flours ...domain.Flour) *Table {
+	extended := &Table{flours: make(map[string]domain.Flour, len(t.flours)+len(flours))}
+	for id, flour := range t.flours {
+		extended.flours[id] = flour
+	}
+	for _, flour := range flours {
+		extended.flours[normalize(flour.ID)] = flour
+	}
+	return extended
+}
+
+func (t *Table) Get(id string) (domain.Flour, error) {
+	flour, ok := t.flours[normalize(id)]
+	if !ok {
+		return domain.Flour{}, fmt.Errorf("%w: %s", domain.ErrUnknownFlour, id)
+	}
+	return flour, nil
+}
+
+// List returns every flour sorted by ID.
+func (t *Table) List() []domain.Flour {
+	flours := make([]domain.Flour, 0, len(t.flours))
+	for _, flour := range t.flours {
+		flours = append(flours, flour)
+	}
+	sort.Slice(flours, func(i, j int) bool {
+		return flours[i].ID < flours[j].ID
+	})
+	return flours
+}
+
+// Blend resolves the flours of a blend through the table and returns the
+// points of hydration the blend adds to the formula.
+func Blend(table domain.FlourTable, parts []domain.BlendPart) ([]domain.BlendFlour, float64, error) {
+	blend := make([]domain.BlendFlour, 0, len(parts))
+	seen := make(map[string]bool, len(parts))
+	total, adjustment := 0.0, 0.0
+	for _, part := range parts {
+		flour, err := table.Get(part.FlourID)
+		if err != nil {
+			return nil, 0, err
+		}
+		if seen[flour.ID] {
+			return nil, 0, fmt.Errorf("%w: %s appears more than once", domain.ErrInvalidBlend, flour.ID)
+		}
+		seen[flour.ID] = true
+		if math.IsNaN(part.Share) || part.Share <= 0 || part.Share > 100 {
+			return nil, 0, fmt.Errorf("%w: share of %s must be greater than 0%% and at most 100%%", domain.ErrInvalidBlend, flour.ID)
+		}
+
+		blend = append(blend, domain.BlendFlour{Flour: flour, Share: part.Share})
+		total += part.Share
+		adjustment += flour.Absorption * part.Share / 100
+	}
+	if math.Abs(total-100) > BlendTolerance {
+		return nil, 0, fmt.Errorf("%w: shares must sum to 100%%, got %g%%", domain.ErrInvalidBlend, total)
+	}
+	return blend, math.Round(adjustment*100) / 100, nil
+}
+
+// Weigh splits a flour weight between the flours of a blend, rounded to
+// hundredths of a gram. The last flour takes the rounding remainder so that
+// the weights sum to the flour.
+func Weigh(blend []domain.BlendFlour, flour float64) []domain.BlendFlour {
+	weighed := make([]domain.BlendFlour, len(blend))
+	remaining := flour
+	for i, part := range blend {
+		part.Weight = math.Round(flour*part.Share) / 100
+		if i == len(blend)-1 {
+			part.Weight = math.Round(remaining*100) / 100
+		}
+		remaining -= part.Weight
+		weighed[i] = part
+	}
+	return weighed
+}
+
+func normalize(id string) string {
+	return strings.ToLower(strings.TrimSpace(id))
+}
@@ -0,0 +1,85 @@
+package flours
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cfioretti/calculator/pkg/domain"
+)
+
+func TestDefaultTable(t *testing.T) {
+	flour, err := Default().Get(" Tipo_00 ")
+	require.NoError(t, err)
+	assert.Equal(t, 0.0, flour.Absorption)
+
+	_, err = Default().Get("cassava")
+	assert.ErrorIs(t, err, domain.ErrUnknownFlour)
+
+	flours := Default().List()
+	for i := 1; i < len(flours); i++ {
+		assert.Less(t, flours[i-1].ID, flours[i].ID)
+	}
+}
+
+func TestTableWith(t *testing.T) {
+	base := NewTable(domain.Flour{ID: "tipo_00"}, domain.Flour{ID: "rye", Absorption: 12})
+	extended := base.With(domain.Flour{ID: "buckwheat", Absorption: 8}, domain.Flour{ID: "rye", Absorption: 15})
+
+	rye, err := extended.Get("rye")
+	require.NoError(t, err)
+	assert.Equal(t, 15.0, rye.Absorption)
+	assert.Len(t, extended.List(), 3)
+
+	rye, _ = base.Get("rye")
+	assert.Equal(t, 12.0, rye.Absorption)
+	_, err = base.Get("buckwheat")
+	assert.ErrorIs(t, err, domain.ErrUnknownFlour)
+}
+
+func TestBlend(t *testing.T) {
+	blend, adjustment, err := Blend(Default(), []domain.BlendPart{
+		{FlourID: "tipo_00", Share: 80},
+		{FlourID: "whole_wheat", Share: 20},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2.0, adjustment)
+	require.Len(t, blend, 2)
+	assert.Equal(t, "Whole wheat", blend[1].Flour.Name)
+	assert.Equal(t, 20.0, blend[1].Share)
+}
+
+func TestBlendInvalid(t *testing.T) {
+	tests := []struct {
+		name     string
+		parts    []domain.BlendPart
+		expected error
+	}{
+		{"unknown flour", []domain.BlendPart{{FlourID: "cassava", Share: 100}}, domain.ErrUnknownFlour},
+		{"shares below 100", []domain.BlendPart{{FlourID: "tipo_00", Share: 70}, {FlourID: "rye", Share: 20}}, domain.ErrInvalidBlend},
+		{"zero share", []domain.BlendPart{{FlourID: "tipo_00", Share: 100}, {FlourID: "rye", Share: 0}}, domain.ErrInvalidBlend},
+		{"repeated flour", []domain.BlendPart{{FlourID: "rye", Share: 50}, {FlourID: "RYE", Share: 50}}, domain.ErrInvalidBlend},
+		{"NaN share", []domain.BlendPart{{FlourID: "tipo_00", Share: 100}, {FlourID: "rye", Share: math.NaN()}}, domain.ErrInvalidBlend},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := Blend(Default(), tt.parts)
+			assert.ErrorIs(t, err, tt.expected)
+		})
+	}
+}
+
+func TestWeigh(t *testing.T) {
+	blend := []domain.BlendFlour{{Flour: domain.Flour{ID: "tipo_00"}, Share: 70}, {Flour: domain.Flour{ID: "rye"}, Share: 30}}
+
+	weighed := Weigh(blend, 1000)
+	assert.Equal(t, 700.0, weighed[0].Weight)
+	assert.Equal(t, 300.0, weighed[1].Weight)
+
+	weighed = Weigh(blend, 714.29)
+	assert.Equal(t, 500.0, weighed[0].Weight)
+	assert.Equal(t, 214.29, weighed[1].Weight)
+	assert.Zero(t, blend[0].Weight)
+}
@@ -0,0 +1,90 @@
+package preferment
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/cfioretti/calculator/internal/domain/yeast"
+	"github.com/cfioretti/calculator/pkg/domain"
+)
+
+const (
+	MinHydration = 40.0
+	MaxHydration = 130.0
+)
+
+// defaults holds, for each preferment type, its hydration and the fresh
+// yeast it takes as a percentage of its flour.
+var defaults = map[domain.PrefermentType]struct {
+	hydration float64
+	yeast     float64
+}{
+	domain.Poolish: {hydration: 100, yeast: 0.1},
+	domain.Biga:    {hydration: 50, yeast: 1},
+	// a levain leavens the dough with its own culture
+	domain.Levain: {hydration: 100, yeast: 0},
+}
+
+// Resolve validates a preferment against the formula of its dough and fills
+// in the default hydration of its type. Exactly one of the flour share and
+// the inoculation must be set; Resolve derives the other.
+func Resolve(formula domain.Formula, preferment domain.Preferment) (domain.Preferment, error) {
+	typeDefaults, ok := defaults[preferment.Type]
+	if !ok {
+		return domain.Preferment{}, fmt.Errorf("%w: unsupported type %s", domain.ErrInvalidPreferment, preferment.Type)
+	}
+	if preferment.Hydration == 0 {
+		preferment.Hydration = typeDefaults.hydration
+	}
+	if preferment.Hydration < MinHydration || preferment.Hydration > MaxHydration {
+		return domain.Preferment{}, fmt.Errorf("%w: hydration must be between %g%% and %g%%", domain.ErrInvalidPreferment, MinHydration, MaxHydration)
+	}
+
+	switch {
+	case preferment.FlourShare != 0 && preferment.Inoculation != 0:
+		return domain.Preferment{}, fmt.Errorf("%w: flour share and inoculation cannot be set together", domain.ErrInvalidPreferment)
+	case preferment.Inoculation != 0:
+		if preferment.Inoculation < 0 {
+			return domain.Preferment{}, fmt.Errorf("%w: inoculation must be positive", domain.ErrInvalidPreferment)
+		}
+		preferment.FlourShare = preferment.Inoculation * 100 / (100 + preferment.Hydration)
+	default:
+		preferment.Inoculation = preferment.FlourShare * (100 + preferment.Hydration) / 100
+	}
+	if preferment.FlourShare <= 0 || preferment.FlourShare > 100 {
+		return domain.Preferment{}, fmt.Errorf("%w: flour share must be greater than 0%% and at most 100%%", domain.ErrInvalidPreferment)
+	}
+	if preferment.FlourShare*preferment.Hydration > formula.Hydration*100 {
+		return domain.Preferment{}, fmt.Errorf("%w: a %s with %g%% of the flour at %g%% hydration needs more water than the %g%% formula holds",
+			domain.ErrInvalidPreferment, preferment.Type, preferment.FlourShare, preferment.Hydration, formula.Hydration)
+	}
+	return preferment, nil
+}
+
+// Split divides the ingredients of a dough made with the formula between a
+// resolved preferment and the final dough. The preferment takes its share
+// of the flour, the water for its hydration and the yeast of its type, up
+// to what the formula holds; everything else goes into the final dough.
+func Split(formula domain.Formula, ingredients domain.Ingredients, preferment domain.Preferment) domain.PrefermentBill {
+	flour := roundHundredths(ingredients.Flour * preferment.FlourShare / 100)
+	water := math.Min(roundHundredths(flour*preferment.Hydration/100), ingredients.Water)
+	fresh := flour * defaults[preferment.Type].yeast / 100
+	yeastWeight := math.Min(roundHundredths(yeast.Convert(fresh, formula.YeastType)), ingredients.Yeast)
+
+	pre := domain.Ingredients{Flour: flour, Water: water, Yeast: yeastWeight}
+	return domain.PrefermentBill{
+		Preferment: pre,
+		FinalDough: domain.Ingredients{
+			Flour: roundHundredths(ingredients.Flour - pre.Flour),
+			Water: roundHundredths(ingredients.Water - pre.Water),
+			Salt:  ingredients.Salt,
+			Yeast: roundHundredths(ingredients.Yeast - pre.Yeast),
+			Oil:   ingredients.Oil,
+			Sugar: ingredients.Sugar,
+		},
+	}
+}
+
+func roundHundredths(value float64) float64 {
+	return math.Round(value*100) / 100
+}
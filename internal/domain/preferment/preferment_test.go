@@ -0,0 +1,118 @@
+package preferment
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cfioretti/calculator/pkg/domain"
+)
+
+func TestResolve(t *testing.T) {
+	poolish, err := Resolve(domain.DefaultFormula, domain.Preferment{Type: domain.Poolish, FlourShare: 30})
+	require.NoError(t, err)
+	assert.Equal(t, 100.0, poolish.Hydration)
+
+	biga, err := Resolve(domain.DefaultFormula, domain.Preferment{Type: domain.Biga, FlourShare: 100})
+	require.NoError(t, err)
+	assert.Equal(t, 50.0, biga.Hydration)
+
+	custom, err := Resolve(domain.DefaultFormula, domain.Preferment{Type: domain.Biga, FlourShare: 40, Hydration: 45})
+	require.NoError(t, err)
+	assert.Equal(t, 45.0, custom.Hydration)
+	assert.Equal(t, 58.0, custom.Inoculation)
+
+	levain, err := Resolve(domain.DefaultFormula, domain.Preferment{Type: domain.Levain, Inoculation: 20})
+	require.NoError(t, err)
+	assert.Equal(t, 100.0, levain.Hydration)
+	assert.Equal(t, 10.0, levain.FlourShare)
+
+	stiff, err := Resolve(domain.DefaultFormula, domain.Preferment{Type: domain.Levain, Inoculation: 30, Hydration: 50})
+	require.NoError(t, err)
+	assert.Equal(t, 20.0, stiff.FlourShare)
+}
+
+func TestResolveInvalid(t *testing.T) {
+	tests := []struct {
+		name       string
+		preferment domain.Preferment
+	}{
+		{"unknown type", domain.Preferment{Type: "sponge", FlourShare: 30}},
+		{"no flour", domain.Preferment{Type: domain.Poolish}},
+		{"more than the flour", domain.Preferment{Type: domain.Poolish, FlourShare: 120}},
+		{"too dry", domain.Preferment{Type: domain.Biga, FlourShare: 40, Hydration: 20}},
+		{"more water than the formula", domain.Preferment{Type: domain.Poolish, FlourShare: 80}},
+		{"flour share and inoculation", domain.Preferment{Type: domain.Levain, FlourShare: 10, Inoculation: 20}},
+		{"negative inoculation", domain.Preferment{Type: domain.Levain, Inoculation: -5}},
+		{"levain heavier than the dough", domain.Preferment{Type: domain.Levain, Inoculation: 250}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Resolve(domain.DefaultFormula, tt.preferment)
+			assert.ErrorIs(t, err, domain.ErrInvalidPreferment)
+		})
+	}
+}
+
+func TestSplit(t *testing.T) {
+	ingredients := domain.Ingredients{Flour: 1000, Water: 650, Salt: 25, Yeast: 5}
+
+	tests := []struct {
+		name       string
+		formula    domain.Formula
+		preferment domain.Preferment
+		expected   domain.PrefermentBill
+	}{
+		{
+			name:       "biga",
+			formula:    domain.DefaultFormula,
+			preferment: domain.Preferment{Type: domain.Biga, FlourShare: 40, Hydration: 50},
+			expected: domain.PrefermentBill{
+				Preferment: domain.Ingredients{Flour: 400, Water: 200, Yeast: 4},
+				FinalDough: domain.Ingredients{Flour: 600, Water: 450, Salt: 25, Yeast: 1},
+			},
+		},
+		{
+			name:       "poolish",
+			formula:    domain.DefaultFormula,
+			preferment: domain.Preferment{Type: domain.Poolish, FlourShare: 30, Hydration: 100},
+			expected: domain.PrefermentBill{
+				Preferment: domain.Ingredients{Flour: 300, Water: 300, Yeast: 0.3},
+				FinalDough: domain.Ingredients{Flour: 700, Water: 350, Salt: 25, Yeast: 4.7},
+			},
+		},
+		{
+			name:       "poolish with instant yeast",
+			formula:    domain.Formula{Hydration: 65, Salt: 2.5, Yeast: 0.5, YeastType: domain.InstantYeast},
+			preferment: domain.Preferment{Type: domain.Poolish, FlourShare: 30, Hydration: 100},
+			expected: domain.PrefermentBill{
+				Preferment: domain.Ingredients{Flour: 300, Water: 300, Yeast: 0.1},
+				FinalDough: domain.Ingredients{Flour: 700, Water: 350, Salt: 25, Yeast: 4.9},
+			},
+		},
+		{
+			name:       "levain",
+			formula:    domain.DefaultFormula,
+			preferment: domain.Preferment{Type: domain.Levain, FlourShare: 10, Hydration: 100, Inoculation: 20},
+			expected: domain.PrefermentBill{
+				Preferment: domain.Ingredients{Flour: 100, Water: 100},
+				FinalDough: domain.Ingredients{Flour: 900, Water: 550, Salt: 25, Yeast: 5},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bill := Split(tt.formula, ingredients, tt.preferment)
+			assert.Equal(t, tt.expected, bill)
+		})
+	}
+}
+
+func TestSplitYeastCappedByFormula(t *testing.T) {
+	ingredients := domain.Ingredients{Flour: 1000, Water: 650, Salt: 25, Yeast: 2}
+	bill := Split(domain.DefaultFormula, ingredients, domain.Preferment{Type: domain.Biga, FlourShare: 100, Hydration: 50})
+	assert.Equal(t, 2.0, bill.Preferment.Yeast)
+	assert.Equal(t, 0.0, bill.FinalDough.Yeast)
+	assert.Equal(t, 150.0, bill.FinalDough.Water)
+}
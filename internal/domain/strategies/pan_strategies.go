@@ -1,82 +1,353 @@
 package strategies
 
 import (
-	"errors"
 	"fmt"
 	"math"
+	"sort"
+	"strconv"
 
 	"github.com/cfioretti/calculator/pkg/domain"
+	"github.com/cfioretti/calculator/pkg/shapes"
 )
 
-type PanStrategy interface {
-	Calculate(measures domain.Measures) (domain.Pan, error)
-}
+type PanStrategy = domain.PanStrategy
 
 type RoundPanStrategy struct{}
 type RectangularPanStrategy struct{}
 type SquarePanStrategy struct{}
+type OvalPanStrategy struct{}
+type RingPanStrategy struct{}
 
+// GetStrategy resolves a shape through the default shape registry.
 func GetStrategy(shape string) (PanStrategy, error) {
-	switch shape {
-	case "round":
-		return &RoundPanStrategy{}, nil
-	case "square":
-		return &SquarePanStrategy{}, nil
-	case "rectangular":
-		return &RectangularPanStrategy{}, nil
-	default:
-		return nil, fmt.Errorf("unsupported shape: %s", shape)
-	}
+	return shapes.Default().Strategy(shape)
 }
 
 func (s *RoundPanStrategy) Calculate(measures domain.Measures) (domain.Pan, error) {
+	return calculateWithRim(measures, s.calculate, insetRound)
+}
+
+func (s *RoundPanStrategy) calculate(measures domain.Measures) (domain.Pan, error) {
 	if measures.Diameter == nil {
-		return domain.Pan{}, errors.New("diameter is required")
+		return domain.Pan{}, invalidMeasures("diameter is required")
+	}
+	if *measures.Diameter <= 0 {
+		return domain.Pan{}, invalidMeasures("diameter must be positive")
+	}
+	if err := validateWalls(measures, measures.TopDiameter); err != nil {
+		return domain.Pan{}, err
 	}
 
 	shape := "round"
-	radius := float64(*measures.Diameter) / 2
-	area := math.Round(math.Pi*radius*radius*100) / 100
-	name := fmt.Sprintf("%s %d cm", shape, *measures.Diameter)
+	radius := *measures.Diameter / 2
+	area := roundArea(math.Pi * radius * radius)
+	name := fmt.Sprintf("%s %s %s", shape, formatMeasure(*measures.Diameter), measures.Unit.OrDefault())
+
+	var sideArea, volume float64
+	if measures.Depth != nil {
+		depth := *measures.Depth
+		topRadius := valueOr(measures.TopDiameter, *measures.Diameter) / 2
+		slant := math.Hypot(topRadius-radius, depth)
+		sideArea = math.Pi * (radius + topRadius) * slant
+		volume = math.Pi * depth / 3 * (radius*radius + radius*topRadius + topRadius*topRadius)
+		name += wallsSuffix(measures, measures.TopDiameter)
+	}
 
 	return domain.Pan{
 		Shape:    shape,
 		Measures: measures,
 		Area:     area,
+		SideArea: roundArea(sideArea),
+		Volume:   roundArea(volume),
 		Name:     name,
 	}, nil
 }
 
 func (s *SquarePanStrategy) Calculate(measures domain.Measures) (domain.Pan, error) {
+	return calculateWithRim(measures, s.calculate, insetSquare)
+}
+
+func (s *SquarePanStrategy) calculate(measures domain.Measures) (domain.Pan, error) {
 	if measures.Edge == nil {
-		return domain.Pan{}, errors.New("edge is required")
+		return domain.Pan{}, invalidMeasures("edge is required")
+	}
+	if *measures.Edge <= 0 {
+		return domain.Pan{}, invalidMeasures("edge must be positive")
+	}
+	if err := validateWalls(measures, measures.TopEdge); err != nil {
+		return domain.Pan{}, err
+	}
+	if err := validateCornerRadius(measures, *measures.Edge, *measures.Edge); err != nil {
+		return domain.Pan{}, err
 	}
 
 	shape := "square"
-	area := float64(*measures.Edge * *measures.Edge)
-	name := fmt.Sprintf("%s %d cm", shape, *measures.Edge)
+	cornerRadius := valueOr(measures.CornerRadius, 0)
+	area := roundArea(*measures.Edge**measures.Edge - cornersArea(cornerRadius))
+	name := fmt.Sprintf("%s %s %s", shape, formatMeasure(*measures.Edge), measures.Unit.OrDefault())
+	name += cornerRadiusSuffix(measures)
+
+	var sideArea, volume float64
+	if measures.Depth != nil {
+		topEdge := valueOr(measures.TopEdge, *measures.Edge)
+		sideArea, volume = rectangularWalls(*measures.Edge, *measures.Edge, topEdge, topEdge, *measures.Depth, cornerRadius)
+		name += wallsSuffix(measures, measures.TopEdge)
+	}
 
 	return domain.Pan{
 		Shape:    shape,
 		Measures: measures,
 		Area:     area,
+		SideArea: roundArea(sideArea),
+		Volume:   roundArea(volume),
 		Name:     name,
 	}, nil
 }
 
 func (s *RectangularPanStrategy) Calculate(measures domain.Measures) (domain.Pan, error) {
+	return calculateWithRim(measures, s.calculate, insetRectangular)
+}
+
+func (s *RectangularPanStrategy) calculate(measures domain.Measures) (domain.Pan, error) {
 	if measures.Width == nil || measures.Length == nil {
-		return domain.Pan{}, errors.New("width and length are required")
+		return domain.Pan{}, invalidMeasures("width and length are required")
+	}
+	if *measures.Width <= 0 || *measures.Length <= 0 {
+		return domain.Pan{}, invalidMeasures("width and length must be positive")
+	}
+	if (measures.TopWidth == nil) != (measures.TopLength == nil) {
+		return domain.Pan{}, invalidMeasures("top width and top length must be given together")
+	}
+	if err := validateWalls(measures, measures.TopWidth); err != nil {
+		return domain.Pan{}, err
+	}
+	if err := validateCornerRadius(measures, *measures.Width, *measures.Length); err != nil {
+		return domain.Pan{}, err
 	}
 
 	shape := "rectangular"
-	area := float64(*measures.Width * *measures.Length)
-	name := fmt.Sprintf("%s %d x %d cm", shape, *measures.Width, *measures.Length)
+	cornerRadius := valueOr(measures.CornerRadius, 0)
+	area := roundArea(*measures.Width**measures.Length - cornersArea(cornerRadius))
+	name := fmt.Sprintf("%s %s x %s %s", shape, formatMeasure(*measures.Width), formatMeasure(*measures.Length), measures.Unit.OrDefault())
+	name += cornerRadiusSuffix(measures)
+
+	var sideArea, volume float64
+	if measures.Depth != nil {
+		topWidth := valueOr(measures.TopWidth, *measures.Width)
+		topLength := valueOr(measures.TopLength, *measures.Length)
+		sideArea, volume = rectangularWalls(*measures.Width, *measures.Length, topWidth, topLength, *measures.Depth, cornerRadius)
+		if measures.TopWidth != nil {
+			name += fmt.Sprintf(", top %s x %s %s", formatMeasure(topWidth), formatMeasure(topLength), measures.Unit.OrDefault())
+		}
+		name += wallsSuffix(measures, nil)
+	}
 
 	return domain.Pan{
 		Shape:    shape,
 		Measures: measures,
 		Area:     area,
+		SideArea: roundArea(sideArea),
+		Volume:   roundArea(volume),
+		Name:     name,
+	}, nil
+}
+
+func (s *OvalPanStrategy) Calculate(measures domain.Measures) (domain.Pan, error) {
+	return calculateWithRim(measures, s.calculate, insetOval)
+}
+
+func (s *OvalPanStrategy) calculate(measures domain.Measures) (domain.Pan, error) {
+	if measures.MajorAxis == nil || measures.MinorAxis == nil {
+		return domain.Pan{}, invalidMeasures("major axis and minor axis are required")
+	}
+	if *measures.MajorAxis <= 0 || *measures.MinorAxis <= 0 {
+		return domain.Pan{}, invalidMeasures("major axis and minor axis must be positive")
+	}
+	if *measures.MinorAxis > *measures.MajorAxis {
+		return domain.Pan{}, invalidMeasures("minor axis cannot be longer than major axis")
+	}
+	if err := validateWalls(measures, nil); err != nil {
+		return domain.Pan{}, err
+	}
+
+	shape := "oval"
+	semiMajor := *measures.MajorAxis / 2
+	semiMinor := *measures.MinorAxis / 2
+	area := math.Pi * semiMajor * semiMinor
+	name := fmt.Sprintf("%s %s x %s %s", shape, formatMeasure(*measures.MajorAxis), formatMeasure(*measures.MinorAxis), measures.Unit.OrDefault())
+
+	var sideArea, volume float64
+	if measures.Depth != nil {
+		// Ramanujan's approximation of the ellipse perimeter
+		h := math.Pow(semiMajor-semiMinor, 2) / math.Pow(semiMajor+semiMinor, 2)
+		perimeter := math.Pi * (semiMajor + semiMinor) * (1 + 3*h/(10+math.Sqrt(4-3*h)))
+		sideArea = perimeter * *measures.Depth
+		volume = area * *measures.Depth
+		name += wallsSuffix(measures, nil)
+	}
+
+	return domain.Pan{
+		Shape:    shape,
+		Measures: measures,
+		Area:     roundArea(area),
+		SideArea: roundArea(sideArea),
+		Volume:   roundArea(volume),
 		Name:     name,
 	}, nil
 }
+
+func (s *RingPanStrategy) Calculate(measures domain.Measures) (domain.Pan, error) {
+	return calculateWithRim(measures, s.calculate, insetRing)
+}
+
+func (s *RingPanStrategy) calculate(measures domain.Measures) (domain.Pan, error) {
+	if measures.OuterDiameter == nil || measures.InnerDiameter == nil {
+		return domain.Pan{}, invalidMeasures("outer diameter and inner diameter are required")
+	}
+	if *measures.InnerDiameter <= 0 {
+		return domain.Pan{}, invalidMeasures("inner diameter must be positive")
+	}
+	if *measures.InnerDiameter >= *measures.OuterDiameter {
+		return domain.Pan{}, invalidMeasures("inner diameter %s must be smaller than outer diameter %s",
+			formatMeasure(*measures.InnerDiameter), formatMeasure(*measures.OuterDiameter))
+	}
+	if err := validateWalls(measures, nil); err != nil {
+		return domain.Pan{}, err
+	}
+
+	shape := "ring"
+	outerRadius := *measures.OuterDiameter / 2
+	innerRadius := *measures.InnerDiameter / 2
+	area := math.Pi * (outerRadius*outerRadius - innerRadius*innerRadius)
+	unit := measures.Unit.OrDefault()
+	name := fmt.Sprintf("%s %s %s, hole %s %s", shape, formatMeasure(*measures.OuterDiameter), unit, formatMeasure(*measures.InnerDiameter), unit)
+
+	var sideArea, volume float64
+	if measures.Depth != nil {
+		sideArea = math.Pi * (*measures.OuterDiameter + *measures.InnerDiameter) * *measures.Depth
+		volume = area * *measures.Depth
+		name += wallsSuffix(measures, nil)
+	}
+
+	return domain.Pan{
+		Shape:    shape,
+		Measures: measures,
+		Area:     roundArea(area),
+		SideArea: roundArea(sideArea),
+		Volume:   roundArea(volume),
+		Name:     name,
+	}, nil
+}
+
+// validateFinite rejects NaN and infinite measures, which compare false with
+// every bound the strategies check.
+func validateFinite(measures domain.Measures) error {
+	values := measures.Values()
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if !finite(values[name]) {
+			return invalidMeasures("%s must be a finite number", name)
+		}
+	}
+	for i, vertex := range measures.Vertices {
+		if !finite(vertex.X) || !finite(vertex.Y) {
+			return invalidMeasures("vertex %d must have finite coordinates", i)
+		}
+	}
+	return nil
+}
+
+func finite(value float64) bool {
+	return !math.IsNaN(value) && !math.IsInf(value, 0)
+}
+
+// validateWalls checks the depth and the optional top measure of a pan.
+// Shapes that cannot flare pass a nil top.
+func validateWalls(measures domain.Measures, top *float64) error {
+	if measures.Depth == nil {
+		if top != nil {
+			return invalidMeasures("top measures require a depth")
+		}
+		return nil
+	}
+	if *measures.Depth <= 0 {
+		return invalidMeasures("depth must be positive")
+	}
+	if top != nil && *top <= 0 {
+		return invalidMeasures("top measures must be positive")
+	}
+	return nil
+}
+
+// rectangularWalls returns the side-wall area and the volume of a pan whose
+// rectangular base flares out to a rectangular top, using the prismatoid formula.
+// Rounded corners are removed as if their radius were constant along the wall.
+func rectangularWalls(width, length, topWidth, topLength, depth, cornerRadius float64) (float64, float64) {
+	widthSlant := math.Hypot((topWidth-width)/2, depth)
+	lengthSlant := math.Hypot((topLength-length)/2, depth)
+	sideArea := (length+topLength)*widthSlant + (width+topWidth)*lengthSlant
+	sideArea -= (8 - 2*math.Pi) * cornerRadius * (widthSlant + lengthSlant) / 2
+
+	middle := (width + topWidth) / 2 * (length + topLength) / 2
+	volume := depth / 6 * (width*length + topWidth*topLength + 4*middle)
+	volume -= cornersArea(cornerRadius) * depth
+	return sideArea, volume
+}
+
+func validateCornerRadius(measures domain.Measures, width, length float64) error {
+	if measures.CornerRadius == nil {
+		return nil
+	}
+	if *measures.CornerRadius < 0 {
+		return invalidMeasures("corner radius cannot be negative")
+	}
+	if *measures.CornerRadius > math.Min(width, length)/2 {
+		return invalidMeasures("corner radius cannot exceed half of the shortest side")
+	}
+	return nil
+}
+
+// cornersArea is the area lost by rounding the four corners of a rectangle.
+func cornersArea(radius float64) float64 {
+	return (4 - math.Pi) * radius * radius
+}
+
+func cornerRadiusSuffix(measures domain.Measures) string {
+	if measures.CornerRadius == nil || *measures.CornerRadius == 0 {
+		return ""
+	}
+	return fmt.Sprintf(", corner radius %s %s", formatMeasure(*measures.CornerRadius), measures.Unit.OrDefault())
+}
+
+func wallsSuffix(measures domain.Measures, top *float64) string {
+	suffix := ""
+	if top != nil {
+		suffix += fmt.Sprintf(", top %s %s", formatMeasure(*top), measures.Unit.OrDefault())
+	}
+	return suffix + fmt.Sprintf(", depth %s %s", formatMeasure(*measures.Depth), measures.Unit.OrDefault())
+}
+
+func valueOr(value *float64, fallback float64) float64 {
+	if value == nil {
+		return fallback
+	}
+	return *value
+}
+
+func roundArea(area float64) float64 {
+	return math.Round(area*100) / 100
+}
+
+func formatMeasure(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
+
+// invalidMeasures reports measures a strategy cannot calculate a pan from.
+func invalidMeasures(format string, args ...any) error {
+	return fmt.Errorf("%w: %s", domain.ErrInvalidMeasures, fmt.Sprintf(format, args...))
+}
@@ -2,6 +2,7 @@ package strategies
 
 import (
 	"github.com/cfioretti/calculator/pkg/domain"
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -16,7 +17,12 @@ func TestGetStrategy(t *testing.T) {
 		{"round shape", "round", false},
 		{"square shape", "square", false},
 		{"rectangular shape", "rectangular", false},
-		{"invalid shape", "triangle", true},
+		{"oval shape", "oval", false},
+		{"ring shape", "ring", false},
+		{"polygon shape", "polygon", false},
+		{"triangle shape", "triangle", false},
+		{"hexagon shape", "hexagon", false},
+		{"invalid shape", "star", true},
 	}
 
 	for _, tt := range tests {
@@ -44,30 +50,157 @@ func TestPanStrategies(t *testing.T) {
 		{
 			name:     "round 20 cm",
 			strategy: &RoundPanStrategy{},
-			measures: domain.Measures{Diameter: intPtr(20)},
+			measures: domain.Measures{Diameter: floatPtr(20)},
 			wantArea: 314.16,
 			wantErr:  false,
 		},
+		{
+			name:     "round 28.5 cm",
+			strategy: &RoundPanStrategy{},
+			measures: domain.Measures{Diameter: floatPtr(28.5)},
+			wantArea: 637.94,
+			wantErr:  false,
+		},
+		{
+			name:     "round 12 in",
+			strategy: &RoundPanStrategy{},
+			measures: domain.Measures{Diameter: floatPtr(12), Unit: domain.Inches},
+			wantArea: 113.1,
+			wantErr:  false,
+		},
 		{
 			name:     "square 20 cm",
 			strategy: &SquarePanStrategy{},
-			measures: domain.Measures{Edge: intPtr(20)},
+			measures: domain.Measures{Edge: floatPtr(20)},
 			wantArea: 400,
 			wantErr:  false,
 		},
 		{
 			name:     "rectangular 20 x 30 cm",
 			strategy: &RectangularPanStrategy{},
-			measures: domain.Measures{Width: intPtr(20), Length: intPtr(30)},
+			measures: domain.Measures{Width: floatPtr(20), Length: floatPtr(30)},
 			wantArea: 600,
 			wantErr:  false,
 		},
+		{
+			name:     "rectangular 25.5 x 35 cm",
+			strategy: &RectangularPanStrategy{},
+			measures: domain.Measures{Width: floatPtr(25.5), Length: floatPtr(35)},
+			wantArea: 892.5,
+			wantErr:  false,
+		},
+		{
+			name:     "rectangular 25 x 35 cm, corner radius 2 cm",
+			strategy: &RectangularPanStrategy{},
+			measures: domain.Measures{Width: floatPtr(25), Length: floatPtr(35), CornerRadius: floatPtr(2)},
+			wantArea: 871.57,
+			wantErr:  false,
+		},
+		{
+			name:     "square 20 cm, corner radius 10 cm",
+			strategy: &SquarePanStrategy{},
+			measures: domain.Measures{Edge: floatPtr(20), CornerRadius: floatPtr(10)},
+			wantArea: 314.16,
+			wantErr:  false,
+		},
+		{
+			name:     "corner radius too large",
+			strategy: &SquarePanStrategy{},
+			measures: domain.Measures{Edge: floatPtr(20), CornerRadius: floatPtr(11)},
+			wantErr:  true,
+		},
+		{
+			name:     "negative corner radius",
+			strategy: &RectangularPanStrategy{},
+			measures: domain.Measures{Width: floatPtr(25), Length: floatPtr(35), CornerRadius: floatPtr(-1)},
+			wantErr:  true,
+		},
+		{
+			name:     "oval 30 x 22 cm",
+			strategy: &OvalPanStrategy{},
+			measures: domain.Measures{MajorAxis: floatPtr(30), MinorAxis: floatPtr(22)},
+			wantArea: 518.36,
+			wantErr:  false,
+		},
+		{
+			name:     "oval with swapped axes",
+			strategy: &OvalPanStrategy{},
+			measures: domain.Measures{MajorAxis: floatPtr(22), MinorAxis: floatPtr(30)},
+			wantErr:  true,
+		},
+		{
+			name:     "ring 30 cm, hole 10 cm",
+			strategy: &RingPanStrategy{},
+			measures: domain.Measures{OuterDiameter: floatPtr(30), InnerDiameter: floatPtr(10)},
+			wantArea: 628.32,
+			wantErr:  false,
+		},
+		{
+			name:     "ring with inner diameter equal to outer",
+			strategy: &RingPanStrategy{},
+			measures: domain.Measures{OuterDiameter: floatPtr(30), InnerDiameter: floatPtr(30)},
+			wantErr:  true,
+		},
+		{
+			name:     "ring without inner diameter",
+			strategy: &RingPanStrategy{},
+			measures: domain.Measures{OuterDiameter: floatPtr(30)},
+			wantErr:  true,
+		},
 		{
 			name:     "invalid measures",
 			strategy: &RoundPanStrategy{},
 			measures: domain.Measures{},
 			wantErr:  true,
 		},
+		{
+			name:     "round with zero diameter",
+			strategy: &RoundPanStrategy{},
+			measures: domain.Measures{Diameter: floatPtr(0)},
+			wantErr:  true,
+		},
+		{
+			name:     "square with negative edge",
+			strategy: &SquarePanStrategy{},
+			measures: domain.Measures{Edge: floatPtr(-30)},
+			wantErr:  true,
+		},
+		{
+			name:     "rectangular with negative width",
+			strategy: &RectangularPanStrategy{},
+			measures: domain.Measures{Width: floatPtr(-30), Length: floatPtr(-40)},
+			wantErr:  true,
+		},
+		{
+			name:     "oval with zero minor axis",
+			strategy: &OvalPanStrategy{},
+			measures: domain.Measures{MajorAxis: floatPtr(30), MinorAxis: floatPtr(0)},
+			wantErr:  true,
+		},
+		{
+			name:     "round with NaN diameter",
+			strategy: &RoundPanStrategy{},
+			measures: domain.Measures{Diameter: floatPtr(math.NaN())},
+			wantErr:  true,
+		},
+		{
+			name:     "square with infinite edge",
+			strategy: &SquarePanStrategy{},
+			measures: domain.Measures{Edge: floatPtr(math.Inf(1))},
+			wantErr:  true,
+		},
+		{
+			name:     "round with NaN depth",
+			strategy: &RoundPanStrategy{},
+			measures: domain.Measures{Diameter: floatPtr(30), Depth: floatPtr(math.NaN())},
+			wantErr:  true,
+		},
+		{
+			name:     "polygon with NaN vertex",
+			strategy: &PolygonPanStrategy{},
+			measures: domain.Measures{Vertices: []domain.Point{{X: 0, Y: 0}, {X: math.NaN(), Y: 0}, {X: 0, Y: 10}}},
+			wantErr:  true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -84,6 +217,85 @@ func TestPanStrategies(t *testing.T) {
 	}
 }
 
-func intPtr(i int) *int {
-	return &i
+func floatPtr(f float64) *float64 {
+	return &f
+}
+
+func TestPanWalls(t *testing.T) {
+	tests := []struct {
+		name         string
+		strategy     PanStrategy
+		measures     domain.Measures
+		wantSideArea float64
+		wantVolume   float64
+		wantErr      bool
+	}{
+		{
+			name:         "round 20 cm, top 24 cm, depth 5 cm",
+			strategy:     &RoundPanStrategy{},
+			measures:     domain.Measures{Diameter: floatPtr(20), TopDiameter: floatPtr(24), Depth: floatPtr(5)},
+			wantSideArea: 372.19,
+			wantVolume:   1905.9,
+		},
+		{
+			name:         "square 20 cm, depth 3 cm",
+			strategy:     &SquarePanStrategy{},
+			measures:     domain.Measures{Edge: floatPtr(20), Depth: floatPtr(3)},
+			wantSideArea: 240,
+			wantVolume:   1200,
+		},
+		{
+			name:         "rectangular 20 x 30 cm, top 22 x 32 cm, depth 4 cm",
+			strategy:     &RectangularPanStrategy{},
+			measures:     domain.Measures{Width: floatPtr(20), Length: floatPtr(30), TopWidth: floatPtr(22), TopLength: floatPtr(32), Depth: floatPtr(4)},
+			wantSideArea: 428.8,
+			wantVolume:   2605.33,
+		},
+		{
+			name:         "square 20 cm, corner radius 2 cm, depth 3 cm",
+			strategy:     &SquarePanStrategy{},
+			measures:     domain.Measures{Edge: floatPtr(20), CornerRadius: floatPtr(2), Depth: floatPtr(3)},
+			wantSideArea: 229.7,
+			wantVolume:   1189.7,
+		},
+		{
+			name:         "ring 30 cm, hole 10 cm, depth 8 cm",
+			strategy:     &RingPanStrategy{},
+			measures:     domain.Measures{OuterDiameter: floatPtr(30), InnerDiameter: floatPtr(10), Depth: floatPtr(8)},
+			wantSideArea: 1005.31,
+			wantVolume:   5026.55,
+		},
+		{
+			name:     "top without depth",
+			strategy: &RoundPanStrategy{},
+			measures: domain.Measures{Diameter: floatPtr(20), TopDiameter: floatPtr(24)},
+			wantErr:  true,
+		},
+		{
+			name:     "negative depth",
+			strategy: &SquarePanStrategy{},
+			measures: domain.Measures{Edge: floatPtr(20), Depth: floatPtr(-1)},
+			wantErr:  true,
+		},
+		{
+			name:     "top width without top length",
+			strategy: &RectangularPanStrategy{},
+			measures: domain.Measures{Width: floatPtr(20), Length: floatPtr(30), TopWidth: floatPtr(22), Depth: floatPtr(4)},
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pan, err := tt.strategy.Calculate(tt.measures)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.name, pan.Name)
+			assert.InDelta(t, tt.wantSideArea, pan.SideArea, 0.01)
+			assert.InDelta(t, tt.wantVolume, pan.Volume, 0.01)
+		})
+	}
 }
@@ -0,0 +1,323 @@
+package strategies
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/cfioretti/calculator/pkg/domain"
+)
+
+type PolygonPanStrategy struct{}
+
+func (s *PolygonPanStrategy) Calculate(measures domain.Measures) (domain.Pan, error) {
+	return calculateWithRim(measures, s.calculate, insetPolygon)
+}
+
+func (s *PolygonPanStrategy) calculate(measures domain.Measures) (domain.Pan, error) {
+	if err := validatePolygon(measures.Vertices); err != nil {
+		return domain.Pan{}, err
+	}
+	if err := validateWalls(measures, nil); err != nil {
+		return domain.Pan{}, err
+	}
+
+	shape := "polygon"
+	unit := measures.Unit.OrDefault()
+	area := shoelaceArea(measures.Vertices)
+	width, length := boundingBox(measures.Vertices)
+	name := fmt.Sprintf("%s %d vertices, %s x %s %s", shape, len(measures.Vertices), formatMeasure(roundArea(width)), formatMeasure(roundArea(length)), unit)
+
+	var sideArea, volume float64
+	if measures.Depth != nil {
+		sideArea = perimeter(measures.Vertices) * *measures.Depth
+		volume = area * *measures.Depth
+		name += wallsSuffix(measures, nil)
+	}
+
+	return domain.Pan{
+		Shape:    shape,
+		Measures: measures,
+		Area:     roundArea(area),
+		SideArea: roundArea(sideArea),
+		Volume:   roundArea(volume),
+		Name:     name,
+	}, nil
+}
+
+func validatePolygon(vertices []domain.Point) error {
+	if len(vertices) < 3 {
+		return invalidMeasures("polygon requires at least 3 vertices, got %d", len(vertices))
+	}
+
+	count := len(vertices)
+	for i := 0; i < count; i++ {
+		if vertices[i] == vertices[(i+1)%count] {
+			return invalidMeasures("vertices %d and %d are identical", i, (i+1)%count)
+		}
+	}
+
+	for i := 0; i < count; i++ {
+		a, b := vertices[i], vertices[(i+1)%count]
+		for j := i + 1; j < count; j++ {
+			c, d := vertices[j], vertices[(j+1)%count]
+			if j == i+1 || (i == 0 && j == count-1) {
+				// adjacent edges only share a vertex unless they fold back on each other
+				if foldsBack(a, b, c, d, j == i+1) {
+					return invalidMeasures("edges %d and %d overlap", i, j)
+				}
+				continue
+			}
+			if segmentsIntersect(a, b, c, d) {
+				return invalidMeasures("polygon is self-intersecting: edges %d and %d cross", i, j)
+			}
+		}
+	}
+
+	if shoelaceArea(vertices) == 0 {
+		return invalidMeasures("polygon area must be positive")
+	}
+	return nil
+}
+
+// shoelaceArea returns the area enclosed by the vertices, whatever their winding order.
+func shoelaceArea(vertices []domain.Point) float64 {
+	return math.Abs(signedArea(vertices))
+}
+
+func perimeter(vertices []domain.Point) float64 {
+	total := 0.0
+	for i, current := range vertices {
+		next := vertices[(i+1)%len(vertices)]
+		total += math.Hypot(next.X-current.X, next.Y-current.Y)
+	}
+	return total
+}
+
+func boundingBox(vertices []domain.Point) (float64, float64) {
+	minX, maxX := vertices[0].X, vertices[0].X
+	minY, maxY := vertices[0].Y, vertices[0].Y
+	for _, vertex := range vertices[1:] {
+		minX, maxX = math.Min(minX, vertex.X), math.Max(maxX, vertex.X)
+		minY, maxY = math.Min(minY, vertex.Y), math.Max(maxY, vertex.Y)
+	}
+	return maxX - minX, maxY - minY
+}
+
+func cross(origin, a, b domain.Point) float64 {
+	return (a.X-origin.X)*(b.Y-origin.Y) - (a.Y-origin.Y)*(b.X-origin.X)
+}
+
+func onSegment(a, b, p domain.Point) bool {
+	return math.Min(a.X, b.X) <= p.X && p.X <= math.Max(a.X, b.X) &&
+		math.Min(a.Y, b.Y) <= p.Y && p.Y <= math.Max(a.Y, b.Y)
+}
+
+func segmentsIntersect(a, b, c, d domain.Point) bool {
+	d1 := cross(c, d, a)
+	d2 := cross(c, d, b)
+	d3 := cross(a, b, c)
+	d4 := cross(a, b, d)
+
+	if ((d1 > 0 && d2 < 0) || (d1 < 0 && d2 > 0)) && ((d3 > 0 && d4 < 0) || (d3 < 0 && d4 > 0)) {
+		return true
+	}
+	return (d1 == 0 && onSegment(c, d, a)) ||
+		(d2 == 0 && onSegment(c, d, b)) ||
+		(d3 == 0 && onSegment(a, b, c)) ||
+		(d4 == 0 && onSegment(a, b, d))
+}
+
+// foldsBack reports whether two adjacent edges run back over each other.
+// When forward is true the edges are a->b, b->d, otherwise c->a, a->b.
+func foldsBack(a, b, c, d domain.Point, forward bool) bool {
+	origin, first, second := b, a, d
+	if !forward {
+		origin, first, second = a, b, c
+	}
+	if cross(origin, first, second) != 0 {
+		return false
+	}
+	return (first.X-origin.X)*(second.X-origin.X)+(first.Y-origin.Y)*(second.Y-origin.Y) > 0
+}
+
+// RegularPolygonPanStrategy handles equal-sided pans. A zero Sides reads the
+// side count from the measures, otherwise the count is fixed by the shape.
+type RegularPolygonPanStrategy struct {
+	Sides int
+}
+
+type TrianglePanStrategy struct{}
+
+const maxRegularPolygonSides = 64
+
+func (s *RegularPolygonPanStrategy) Calculate(measures domain.Measures) (domain.Pan, error) {
+	return calculateWithRim(measures, s.calculate, s.inset)
+}
+
+func (s *RegularPolygonPanStrategy) calculate(measures domain.Measures) (domain.Pan, error) {
+	sides := s.Sides
+	if measures.Sides != nil {
+		if sides != 0 && *measures.Sides != sides {
+			return domain.Pan{}, invalidMeasures("%s has %d sides, got %d", polygonNoun(sides), sides, *measures.Sides)
+		}
+		sides = *measures.Sides
+	}
+	if sides == 0 {
+		return domain.Pan{}, invalidMeasures("sides is required")
+	}
+	if sides < 3 || sides > maxRegularPolygonSides {
+		return domain.Pan{}, invalidMeasures("sides must be between 3 and %d", maxRegularPolygonSides)
+	}
+	if (measures.Edge == nil) == (measures.Circumradius == nil) {
+		return domain.Pan{}, invalidMeasures("exactly one of edge and circumradius is required")
+	}
+	if err := validateWalls(measures, nil); err != nil {
+		return domain.Pan{}, err
+	}
+
+	shape := "regular_polygon"
+	unit := measures.Unit.OrDefault()
+	n := float64(sides)
+
+	var edge float64
+	var name string
+	if measures.Edge != nil {
+		if *measures.Edge <= 0 {
+			return domain.Pan{}, invalidMeasures("edge must be positive")
+		}
+		edge = *measures.Edge
+		name = fmt.Sprintf("regular %s, edge %s %s", polygonNoun(sides), formatMeasure(edge), unit)
+	} else {
+		if *measures.Circumradius <= 0 {
+			return domain.Pan{}, invalidMeasures("circumradius must be positive")
+		}
+		edge = 2 * *measures.Circumradius * math.Sin(math.Pi/n)
+		name = fmt.Sprintf("regular %s, circumradius %s %s", polygonNoun(sides), formatMeasure(*measures.Circumradius), unit)
+	}
+	area := n * edge * edge / (4 * math.Tan(math.Pi/n))
+
+	var sideArea, volume float64
+	if measures.Depth != nil {
+		sideArea = n * edge * *measures.Depth
+		volume = area * *measures.Depth
+		name += wallsSuffix(measures, nil)
+	}
+
+	return domain.Pan{
+		Shape:    shape,
+		Measures: measures,
+		Area:     roundArea(area),
+		SideArea: roundArea(sideArea),
+		Volume:   roundArea(volume),
+		Name:     name,
+	}, nil
+}
+
+func (s *TrianglePanStrategy) Calculate(measures domain.Measures) (domain.Pan, error) {
+	return calculateWithRim(measures, s.calculate, s.inset)
+}
+
+func (s *TrianglePanStrategy) calculate(measures domain.Measures) (domain.Pan, error) {
+	if measures.SideA == nil || measures.SideB == nil || measures.SideC == nil {
+		return domain.Pan{}, invalidMeasures("side a, side b and side c are required")
+	}
+	a, b, c := *measures.SideA, *measures.SideB, *measures.SideC
+	if a <= 0 || b <= 0 || c <= 0 {
+		return domain.Pan{}, invalidMeasures("triangle sides must be positive")
+	}
+	if a+b <= c || a+c <= b || b+c <= a {
+		return domain.Pan{}, invalidMeasures("triangle sides violate the triangle inequality")
+	}
+	if err := validateWalls(measures, nil); err != nil {
+		return domain.Pan{}, err
+	}
+
+	shape := "triangle"
+	semiPerimeter := (a + b + c) / 2
+	area := math.Sqrt(semiPerimeter * (semiPerimeter - a) * (semiPerimeter - b) * (semiPerimeter - c))
+	name := fmt.Sprintf("%s %s x %s x %s %s", shape, formatMeasure(a), formatMeasure(b), formatMeasure(c), measures.Unit.OrDefault())
+
+	var sideArea, volume float64
+	if measures.Depth != nil {
+		sideArea = (a + b + c) * *measures.Depth
+		volume = area * *measures.Depth
+		name += wallsSuffix(measures, nil)
+	}
+
+	return domain.Pan{
+		Shape:    shape,
+		Measures: measures,
+		Area:     roundArea(area),
+		SideArea: roundArea(sideArea),
+		Volume:   roundArea(volume),
+		Name:     name,
+	}, nil
+}
+
+// inset scales the polygon about its centre so that the inradius shrinks
+// by width.
+func (s *RegularPolygonPanStrategy) inset(measures domain.Measures, width float64) (domain.Measures, bool) {
+	sides := s.Sides
+	if measures.Sides != nil {
+		sides = *measures.Sides
+	}
+	n := float64(sides)
+
+	var inradius float64
+	if measures.Edge != nil {
+		inradius = *measures.Edge / (2 * math.Tan(math.Pi/n))
+	} else {
+		inradius = *measures.Circumradius * math.Cos(math.Pi/n)
+	}
+	if width >= inradius {
+		return measures, false
+	}
+
+	scale := (inradius - width) / inradius
+	measures.Edge = scaled(measures.Edge, scale)
+	measures.Circumradius = scaled(measures.Circumradius, scale)
+	return measures, true
+}
+
+// inset scales the triangle about its incentre so that the inradius
+// shrinks by width.
+func (s *TrianglePanStrategy) inset(measures domain.Measures, width float64) (domain.Measures, bool) {
+	a, b, c := *measures.SideA, *measures.SideB, *measures.SideC
+	semiPerimeter := (a + b + c) / 2
+	area := math.Sqrt(semiPerimeter * (semiPerimeter - a) * (semiPerimeter - b) * (semiPerimeter - c))
+	inradius := area / semiPerimeter
+	if width >= inradius {
+		return measures, false
+	}
+
+	scale := (inradius - width) / inradius
+	measures.SideA = scaled(measures.SideA, scale)
+	measures.SideB = scaled(measures.SideB, scale)
+	measures.SideC = scaled(measures.SideC, scale)
+	return measures, true
+}
+
+func scaled(value *float64, scale float64) *float64 {
+	if value == nil {
+		return nil
+	}
+	result := *value * scale
+	return &result
+}
+
+func polygonNoun(sides int) string {
+	switch sides {
+	case 3:
+		return "triangle"
+	case 4:
+		return "square"
+	case 5:
+		return "pentagon"
+	case 6:
+		return "hexagon"
+	case 8:
+		return "octagon"
+	default:
+		return fmt.Sprintf("%d-gon", sides)
+	}
+}
@@ -0,0 +1,186 @@
+package strategies
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cfioretti/calculator/pkg/domain"
+)
+
+func TestPolygonPanStrategy(t *testing.T) {
+	tests := []struct {
+		name     string
+		vertices []domain.Point
+		wantName string
+		wantArea float64
+		wantErr  bool
+	}{
+		{
+			name:     "rectangle counter-clockwise",
+			vertices: []domain.Point{{X: 0, Y: 0}, {X: 30, Y: 0}, {X: 30, Y: 40}, {X: 0, Y: 40}},
+			wantName: "polygon 4 vertices, 30 x 40 cm",
+			wantArea: 1200,
+		},
+		{
+			name:     "rectangle clockwise",
+			vertices: []domain.Point{{X: 0, Y: 0}, {X: 0, Y: 40}, {X: 30, Y: 40}, {X: 30, Y: 0}},
+			wantName: "polygon 4 vertices, 30 x 40 cm",
+			wantArea: 1200,
+		},
+		{
+			name:     "concave L-shaped tray",
+			vertices: []domain.Point{{X: 0, Y: 0}, {X: 40, Y: 0}, {X: 40, Y: 20}, {X: 20, Y: 20}, {X: 20, Y: 40}, {X: 0, Y: 40}},
+			wantName: "polygon 6 vertices, 40 x 40 cm",
+			wantArea: 1200,
+		},
+		{
+			name:     "fewer than three vertices",
+			vertices: []domain.Point{{X: 0, Y: 0}, {X: 30, Y: 0}},
+			wantErr:  true,
+		},
+		{
+			name:     "self-intersecting bow tie",
+			vertices: []domain.Point{{X: 0, Y: 0}, {X: 30, Y: 30}, {X: 30, Y: 0}, {X: 0, Y: 30}},
+			wantErr:  true,
+		},
+		{
+			name:     "duplicated vertex",
+			vertices: []domain.Point{{X: 0, Y: 0}, {X: 30, Y: 0}, {X: 30, Y: 0}, {X: 0, Y: 30}},
+			wantErr:  true,
+		},
+		{
+			name:     "collinear vertices",
+			vertices: []domain.Point{{X: 0, Y: 0}, {X: 10, Y: 0}, {X: 20, Y: 0}},
+			wantErr:  true,
+		},
+		{
+			name:     "edge folding back",
+			vertices: []domain.Point{{X: 0, Y: 0}, {X: 30, Y: 0}, {X: 10, Y: 0}, {X: 10, Y: 20}},
+			wantErr:  true,
+		},
+	}
+
+	strategy := &PolygonPanStrategy{}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pan, err := strategy.Calculate(domain.Measures{Vertices: tt.vertices})
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantName, pan.Name)
+			assert.Equal(t, tt.wantArea, pan.Area)
+		})
+	}
+}
+
+func TestPolygonPanStrategyWalls(t *testing.T) {
+	strategy := &PolygonPanStrategy{}
+	measures := domain.Measures{
+		Vertices: []domain.Point{{X: 0, Y: 0}, {X: 30, Y: 0}, {X: 30, Y: 40}, {X: 0, Y: 40}},
+		Depth:    floatPtr(3),
+	}
+
+	pan, err := strategy.Calculate(measures)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "polygon 4 vertices, 30 x 40 cm, depth 3 cm", pan.Name)
+	assert.Equal(t, 420.0, pan.SideArea)
+	assert.Equal(t, 3600.0, pan.Volume)
+}
+
+func TestRegularPolygonPanStrategy(t *testing.T) {
+	tests := []struct {
+		name     string
+		strategy PanStrategy
+		measures domain.Measures
+		wantName string
+		wantArea float64
+		wantErr  bool
+	}{
+		{
+			name:     "hexagon by edge",
+			strategy: &RegularPolygonPanStrategy{Sides: 6},
+			measures: domain.Measures{Edge: floatPtr(12)},
+			wantName: "regular hexagon, edge 12 cm",
+			wantArea: 374.12,
+		},
+		{
+			name:     "octagon by circumradius",
+			strategy: &RegularPolygonPanStrategy{Sides: 8},
+			measures: domain.Measures{Circumradius: floatPtr(15)},
+			wantName: "regular octagon, circumradius 15 cm",
+			wantArea: 636.4,
+		},
+		{
+			name:     "sides from measures",
+			strategy: &RegularPolygonPanStrategy{},
+			measures: domain.Measures{Sides: intPtr(10), Edge: floatPtr(10)},
+			wantName: "regular 10-gon, edge 10 cm",
+			wantArea: 769.42,
+		},
+		{
+			name:     "missing sides",
+			strategy: &RegularPolygonPanStrategy{},
+			measures: domain.Measures{Edge: floatPtr(10)},
+			wantErr:  true,
+		},
+		{
+			name:     "conflicting sides",
+			strategy: &RegularPolygonPanStrategy{Sides: 6},
+			measures: domain.Measures{Sides: intPtr(8), Edge: floatPtr(10)},
+			wantErr:  true,
+		},
+		{
+			name:     "too few sides",
+			strategy: &RegularPolygonPanStrategy{},
+			measures: domain.Measures{Sides: intPtr(2), Edge: floatPtr(10)},
+			wantErr:  true,
+		},
+		{
+			name:     "edge and circumradius together",
+			strategy: &RegularPolygonPanStrategy{Sides: 6},
+			measures: domain.Measures{Edge: floatPtr(10), Circumradius: floatPtr(10)},
+			wantErr:  true,
+		},
+		{
+			name:     "right triangle",
+			strategy: &TrianglePanStrategy{},
+			measures: domain.Measures{SideA: floatPtr(30), SideB: floatPtr(40), SideC: floatPtr(50)},
+			wantName: "triangle 30 x 40 x 50 cm",
+			wantArea: 600,
+		},
+		{
+			name:     "triangle inequality violated",
+			strategy: &TrianglePanStrategy{},
+			measures: domain.Measures{SideA: floatPtr(10), SideB: floatPtr(10), SideC: floatPtr(25)},
+			wantErr:  true,
+		},
+		{
+			name:     "missing triangle side",
+			strategy: &TrianglePanStrategy{},
+			measures: domain.Measures{SideA: floatPtr(30), SideB: floatPtr(40)},
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pan, err := tt.strategy.Calculate(tt.measures)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantName, pan.Name)
+			assert.Equal(t, tt.wantArea, pan.Area)
+		})
+	}
+}
+
+func intPtr(i int) *int {
+	return &i
+}
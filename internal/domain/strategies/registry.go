@@ -0,0 +1,177 @@
+package strategies
+
+import (
+	"github.com/cfioretti/calculator/pkg/shapes"
+)
+
+const (
+	minPanMeasure = 5.0
+	maxPanMeasure = 200.0
+	minDepth      = 0.5
+	maxDepth      = 30.0
+	maxWall       = 2.0
+	maxBorder     = 10.0
+)
+
+func init() {
+	shapes.MustRegister(shapes.Shape{
+		Name:    "round",
+		Aliases: []string{"circle", "circular"},
+		Measures: withWalls(
+			length("diameter", true, "Bottom diameter"),
+			length("topDiameter", false, "Top diameter of a flared pan, requires depth"),
+		),
+		Description: "Round pan, optionally deep with flared walls",
+		Strategy:    &RoundPanStrategy{},
+	})
+	shapes.MustRegister(shapes.Shape{
+		Name: "square",
+		Measures: withWalls(
+			length("edge", true, "Bottom edge"),
+			length("topEdge", false, "Top edge of a flared pan, requires depth"),
+			cornerRadius(),
+		),
+		Description: "Square pan, optionally with rounded corners and flared walls",
+		Strategy:    &SquarePanStrategy{},
+	})
+	shapes.MustRegister(shapes.Shape{
+		Name:    "rectangular",
+		Aliases: []string{"rectangle"},
+		Measures: withWalls(
+			length("width", true, "Bottom width"),
+			length("length", true, "Bottom length"),
+			length("topWidth", false, "Top width of a flared pan, requires depth and top length"),
+			length("topLength", false, "Top length of a flared pan, requires depth and top width"),
+			cornerRadius(),
+		),
+		Description: "Rectangular pan, optionally with rounded corners and flared walls",
+		Strategy:    &RectangularPanStrategy{},
+	})
+	shapes.MustRegister(shapes.Shape{
+		Name:    "oval",
+		Aliases: []string{"ellipse", "elliptical"},
+		Measures: withWalls(
+			length("majorAxis", true, "Longest axis"),
+			length("minorAxis", true, "Shortest axis, not longer than the major axis"),
+		),
+		Description: "Oval pan described by its major and minor axes",
+		Strategy:    &OvalPanStrategy{},
+	})
+	shapes.MustRegister(shapes.Shape{
+		Name:    "ring",
+		Aliases: []string{"annulus", "crown"},
+		Measures: withWalls(
+			length("outerDiameter", true, "Outer diameter"),
+			shapes.MeasureSpec{Name: "innerDiameter", Kind: shapes.LengthMeasure, Required: true, Min: 1, Max: maxPanMeasure, Description: "Diameter of the central hole, smaller than the outer diameter"},
+		),
+		Description: "Ring pan with a central hole",
+		Strategy:    &RingPanStrategy{},
+	})
+	shapes.MustRegister(shapes.Shape{
+		Name:    "polygon",
+		Aliases: []string{"custom"},
+		Measures: withWalls(
+			shapes.MeasureSpec{Name: "vertices", Kind: shapes.PointsMeasure, Required: true, Min: -maxPanMeasure, Max: maxPanMeasure, Description: "At least 3 ordered, non self-intersecting vertices"},
+		),
+		Description: "Custom pan outlined by an ordered list of vertices",
+		Strategy:    &PolygonPanStrategy{},
+	})
+	shapes.MustRegister(shapes.Shape{
+		Name:        "regular_polygon",
+		Aliases:     []string{"ngon"},
+		Measures:    withWalls(append([]shapes.MeasureSpec{sides()}, regularPolygonMeasures()...)...),
+		Description: "Equal-sided pan described by its side count and either edge or circumradius",
+		Strategy:    &RegularPolygonPanStrategy{},
+	})
+	shapes.MustRegister(shapes.Shape{
+		Name:        "hexagon",
+		Aliases:     []string{"hexagonal"},
+		Measures:    withWalls(regularPolygonMeasures()...),
+		Description: "Regular hexagonal pan described by either edge or circumradius",
+		Strategy:    &RegularPolygonPanStrategy{Sides: 6},
+	})
+	shapes.MustRegister(shapes.Shape{
+		Name:        "octagon",
+		Aliases:     []string{"octagonal"},
+		Measures:    withWalls(regularPolygonMeasures()...),
+		Description: "Regular octagonal pan described by either edge or circumradius",
+		Strategy:    &RegularPolygonPanStrategy{Sides: 8},
+	})
+	shapes.MustRegister(shapes.Shape{
+		Name:    "triangle",
+		Aliases: []string{"triangular"},
+		Measures: withWalls(
+			length("sideA", true, "First side"),
+			length("sideB", true, "Second side"),
+			length("sideC", true, "Third side, shorter than the other two combined"),
+		),
+		Description: "Triangular pan described by its three sides",
+		Strategy:    &TrianglePanStrategy{},
+	})
+}
+
+func length(name string, required bool, description string) shapes.MeasureSpec {
+	return shapes.MeasureSpec{
+		Name:        name,
+		Kind:        shapes.LengthMeasure,
+		Required:    required,
+		Min:         minPanMeasure,
+		Max:         maxPanMeasure,
+		Description: description,
+	}
+}
+
+func cornerRadius() shapes.MeasureSpec {
+	return shapes.MeasureSpec{
+		Name:        "cornerRadius",
+		Kind:        shapes.LengthMeasure,
+		Min:         0,
+		Max:         maxPanMeasure / 2,
+		Description: "Radius of the rounded corners, at most half of the shortest side",
+	}
+}
+
+func sides() shapes.MeasureSpec {
+	return shapes.MeasureSpec{
+		Name:        "sides",
+		Kind:        shapes.CountMeasure,
+		Required:    true,
+		Min:         3,
+		Max:         maxRegularPolygonSides,
+		Description: "Number of sides",
+	}
+}
+
+func regularPolygonMeasures() []shapes.MeasureSpec {
+	edge := length("edge", false, "Side length, exclusive with circumradius")
+	edge.ConflictsWith = []string{"circumradius"}
+	return []shapes.MeasureSpec{
+		edge,
+		{Name: "circumradius", Kind: shapes.LengthMeasure, Min: minPanMeasure / 2, Max: maxPanMeasure / 2, Description: "Distance from the centre to a vertex, exclusive with edge", ConflictsWith: []string{"edge"}},
+	}
+}
+
+// withWalls appends the depth and rim measures every shape accepts.
+func withWalls(measures ...shapes.MeasureSpec) []shapes.MeasureSpec {
+	return append(measures,
+		shapes.MeasureSpec{
+			Name:        "depth",
+			Kind:        shapes.LengthMeasure,
+			Min:         minDepth,
+			Max:         maxDepth,
+			Description: "Wall height, enables volume and side-wall area",
+		},
+		shapes.MeasureSpec{
+			Name:        "wallThickness",
+			Kind:        shapes.LengthMeasure,
+			Max:         maxWall,
+			Description: "Thickness of the walls when the pan is measured outside, excluded from the dough area",
+		},
+		shapes.MeasureSpec{
+			Name:        "crustBorder",
+			Kind:        shapes.LengthMeasure,
+			Max:         maxBorder,
+			Description: "Width of the crust border left without topping",
+		},
+	)
+}
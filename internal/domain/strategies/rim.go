@@ -0,0 +1,201 @@
+package strategies
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/cfioretti/calculator/pkg/domain"
+)
+
+// insetFunc returns the measures of the outline moved inwards by the given
+// width on every side, or false when nothing is left of it.
+type insetFunc func(measures domain.Measures, width float64) (domain.Measures, bool)
+
+// calculateWithRim calculates a pan from the measures taken outside its
+// walls. The dough fills the outline inset by the wall thickness, and the
+// topping stops at the crust border inside it.
+func calculateWithRim(measures domain.Measures, calculate func(domain.Measures) (domain.Pan, error), inset insetFunc) (domain.Pan, error) {
+	if err := validateFinite(measures); err != nil {
+		return domain.Pan{}, err
+	}
+	wall := valueOr(measures.WallThickness, 0)
+	border := valueOr(measures.CrustBorder, 0)
+	if wall < 0 {
+		return domain.Pan{}, invalidMeasures("wall thickness cannot be negative")
+	}
+	if border < 0 {
+		return domain.Pan{}, invalidMeasures("crust border cannot be negative")
+	}
+
+	pan, err := calculate(measures)
+	if err != nil {
+		return domain.Pan{}, err
+	}
+	pan.DoughArea = pan.Area
+	pan.ToppingArea = pan.Area
+	unit := measures.Unit.OrDefault()
+
+	if wall > 0 {
+		innerMeasures, ok := inset(measures, wall)
+		if !ok {
+			return domain.Pan{}, invalidMeasures("wall thickness %s %s leaves no room for dough", formatMeasure(wall), unit)
+		}
+		inner, err := calculate(innerMeasures)
+		if err != nil {
+			return domain.Pan{}, err
+		}
+		pan.DoughArea = inner.Area
+		pan.ToppingArea = inner.Area
+		pan.SideArea = inner.SideArea
+		pan.Volume = inner.Volume
+		pan.Name += fmt.Sprintf(", wall %s %s", formatMeasure(wall), unit)
+	}
+
+	if border > 0 {
+		toppingMeasures, ok := inset(measures, wall+border)
+		if !ok {
+			return domain.Pan{}, invalidMeasures("crust border %s %s leaves no room for topping", formatMeasure(border), unit)
+		}
+		topping, err := calculate(toppingMeasures)
+		if err != nil {
+			return domain.Pan{}, err
+		}
+		pan.ToppingArea = topping.Area
+		pan.Name += fmt.Sprintf(", border %s %s", formatMeasure(border), unit)
+	}
+	return pan, nil
+}
+
+// shrink returns a copy of a measure reduced by delta, keeping nil measures
+// unset.
+func shrink(value *float64, delta float64) *float64 {
+	if value == nil {
+		return nil
+	}
+	shrunk := *value - delta
+	return &shrunk
+}
+
+func positive(values ...*float64) bool {
+	for _, value := range values {
+		if value != nil && *value <= 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// insetCornerRadius follows a rounded corner inwards; sharp corners stay sharp.
+func insetCornerRadius(radius *float64, width float64) *float64 {
+	if radius == nil {
+		return nil
+	}
+	inset := math.Max(*radius-width, 0)
+	return &inset
+}
+
+func insetRound(measures domain.Measures, width float64) (domain.Measures, bool) {
+	measures.Diameter = shrink(measures.Diameter, 2*width)
+	measures.TopDiameter = shrink(measures.TopDiameter, 2*width)
+	return measures, positive(measures.Diameter, measures.TopDiameter)
+}
+
+func insetSquare(measures domain.Measures, width float64) (domain.Measures, bool) {
+	measures.Edge = shrink(measures.Edge, 2*width)
+	measures.TopEdge = shrink(measures.TopEdge, 2*width)
+	measures.CornerRadius = insetCornerRadius(measures.CornerRadius, width)
+	return measures, positive(measures.Edge, measures.TopEdge)
+}
+
+func insetRectangular(measures domain.Measures, width float64) (domain.Measures, bool) {
+	measures.Width = shrink(measures.Width, 2*width)
+	measures.Length = shrink(measures.Length, 2*width)
+	measures.TopWidth = shrink(measures.TopWidth, 2*width)
+	measures.TopLength = shrink(measures.TopLength, 2*width)
+	measures.CornerRadius = insetCornerRadius(measures.CornerRadius, width)
+	return measures, positive(measures.Width, measures.Length, measures.TopWidth, measures.TopLength)
+}
+
+// insetOval shrinks both axes, which is close to the true inward offset of
+// an ellipse for walls and borders much thinner than the minor axis.
+func insetOval(measures domain.Measures, width float64) (domain.Measures, bool) {
+	measures.MajorAxis = shrink(measures.MajorAxis, 2*width)
+	measures.MinorAxis = shrink(measures.MinorAxis, 2*width)
+	return measures, positive(measures.MajorAxis, measures.MinorAxis)
+}
+
+// insetRing moves both the outer wall and the wall around the hole.
+func insetRing(measures domain.Measures, width float64) (domain.Measures, bool) {
+	measures.OuterDiameter = shrink(measures.OuterDiameter, 2*width)
+	measures.InnerDiameter = shrink(measures.InnerDiameter, -2*width)
+	return measures, *measures.InnerDiameter < *measures.OuterDiameter
+}
+
+// insetPolygon offsets every edge inwards and joins them with mitred
+// corners, the way sheet-metal walls follow the outline of a pan.
+func insetPolygon(measures domain.Measures, width float64) (domain.Measures, bool) {
+	vertices := measures.Vertices
+	count := len(vertices)
+	// inward normals point left of the edges of a counter-clockwise outline
+	orientation := 1.0
+	if signedArea(vertices) < 0 {
+		orientation = -1
+	}
+
+	inset := make([]domain.Point, count)
+	for i, vertex := range vertices {
+		previous := vertices[(i+count-1)%count]
+		next := vertices[(i+1)%count]
+		inset[i] = mitre(previous, vertex, next, width*orientation)
+	}
+
+	for i := range inset {
+		next := (i + 1) % count
+		original := domain.Point{X: vertices[next].X - vertices[i].X, Y: vertices[next].Y - vertices[i].Y}
+		moved := domain.Point{X: inset[next].X - inset[i].X, Y: inset[next].Y - inset[i].Y}
+		if original.X*moved.X+original.Y*moved.Y <= 0 {
+			// the edge collapsed or turned around
+			return measures, false
+		}
+	}
+	if validatePolygon(inset) != nil || signedArea(inset)*orientation <= 0 {
+		return measures, false
+	}
+
+	measures.Vertices = inset
+	return measures, true
+}
+
+// mitre returns where the two edges meeting at vertex cross once both are
+// moved left by offset.
+func mitre(previous, vertex, next domain.Point, offset float64) domain.Point {
+	in := unitVector(previous, vertex)
+	out := unitVector(vertex, next)
+	inNormal := domain.Point{X: -in.Y, Y: in.X}
+	outNormal := domain.Point{X: -out.Y, Y: out.X}
+
+	denominator := 1 + inNormal.X*outNormal.X + inNormal.Y*outNormal.Y
+	if math.Abs(denominator) < 1e-12 {
+		// the outline turns back on itself, which validatePolygon rejects
+		return domain.Point{X: vertex.X + inNormal.X*offset, Y: vertex.Y + inNormal.Y*offset}
+	}
+	// the bisector of the two normals, long enough to keep offset from both edges
+	return domain.Point{
+		X: vertex.X + (inNormal.X+outNormal.X)*offset/denominator,
+		Y: vertex.Y + (inNormal.Y+outNormal.Y)*offset/denominator,
+	}
+}
+
+func unitVector(from, to domain.Point) domain.Point {
+	length := math.Hypot(to.X-from.X, to.Y-from.Y)
+	return domain.Point{X: (to.X - from.X) / length, Y: (to.Y - from.Y) / length}
+}
+
+func signedArea(vertices []domain.Point) float64 {
+	sum := 0.0
+	for i, current := range vertices {
+		next := vertices[(i+1)%len(vertices)]
+		sum += current.X*next.Y - next.X*current.Y
+	}
+	return sum / 2
+}
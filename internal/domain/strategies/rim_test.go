@@ -0,0 +1,164 @@
+package strategies
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cfioretti/calculator/pkg/domain"
+)
+
+func TestRimMeasures(t *testing.T) {
+	tests := []struct {
+		name            string
+		strategy        PanStrategy
+		measures        domain.Measures
+		wantArea        float64
+		wantDoughArea   float64
+		wantToppingArea float64
+		wantName        string
+	}{
+		{
+			name:            "round without rim",
+			strategy:        &RoundPanStrategy{},
+			measures:        domain.Measures{Diameter: floatPtr(30)},
+			wantArea:        706.86,
+			wantDoughArea:   706.86,
+			wantToppingArea: 706.86,
+			wantName:        "round 30 cm",
+		},
+		{
+			name:            "round with wall and border",
+			strategy:        &RoundPanStrategy{},
+			measures:        domain.Measures{Diameter: floatPtr(30), WallThickness: floatPtr(0.5), CrustBorder: floatPtr(2)},
+			wantArea:        706.86,
+			wantDoughArea:   660.52,
+			wantToppingArea: 490.87,
+			wantName:        "round 30 cm, wall 0.5 cm, border 2 cm",
+		},
+		{
+			name:            "rectangular with rounded corners",
+			strategy:        &RectangularPanStrategy{},
+			measures:        domain.Measures{Width: floatPtr(30), Length: floatPtr(40), CornerRadius: floatPtr(2), WallThickness: floatPtr(1), CrustBorder: floatPtr(3)},
+			wantArea:        1196.57,
+			wantDoughArea:   1063.14,
+			wantToppingArea: 704,
+			wantName:        "rectangular 30 x 40 cm, corner radius 2 cm, wall 1 cm, border 3 cm",
+		},
+		{
+			name:            "ring walls on both sides",
+			strategy:        &RingPanStrategy{},
+			measures:        domain.Measures{OuterDiameter: floatPtr(30), InnerDiameter: floatPtr(10), WallThickness: floatPtr(1)},
+			wantArea:        628.32,
+			wantDoughArea:   502.65,
+			wantToppingArea: 502.65,
+			wantName:        "ring 30 cm, hole 10 cm, wall 1 cm",
+		},
+		{
+			name:            "triangle border halving the inradius",
+			strategy:        &TrianglePanStrategy{},
+			measures:        domain.Measures{SideA: floatPtr(30), SideB: floatPtr(40), SideC: floatPtr(50), CrustBorder: floatPtr(5)},
+			wantArea:        600,
+			wantDoughArea:   600,
+			wantToppingArea: 150,
+			wantName:        "triangle 30 x 40 x 50 cm, border 5 cm",
+		},
+		{
+			name:     "concave polygon with mitred walls",
+			strategy: &PolygonPanStrategy{},
+			measures: domain.Measures{
+				Vertices:      []domain.Point{{X: 0, Y: 0}, {X: 20, Y: 0}, {X: 20, Y: 10}, {X: 10, Y: 10}, {X: 10, Y: 20}, {X: 0, Y: 20}},
+				WallThickness: floatPtr(1),
+			},
+			wantArea:        300,
+			wantDoughArea:   224,
+			wantToppingArea: 224,
+			wantName:        "polygon 6 vertices, 20 x 20 cm, wall 1 cm",
+		},
+		{
+			name:     "clockwise polygon",
+			strategy: &PolygonPanStrategy{},
+			measures: domain.Measures{
+				Vertices:    []domain.Point{{X: 0, Y: 0}, {X: 0, Y: 30}, {X: 40, Y: 30}, {X: 40, Y: 0}},
+				CrustBorder: floatPtr(1),
+			},
+			wantArea:        1200,
+			wantDoughArea:   1200,
+			wantToppingArea: 1064,
+			wantName:        "polygon 4 vertices, 40 x 30 cm, border 1 cm",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pan, err := tt.strategy.Calculate(tt.measures)
+			require.NoError(t, err)
+			assert.InDelta(t, tt.wantArea, pan.Area, 0.01)
+			assert.InDelta(t, tt.wantDoughArea, pan.DoughArea, 0.01)
+			assert.InDelta(t, tt.wantToppingArea, pan.ToppingArea, 0.01)
+			assert.Equal(t, tt.wantName, pan.Name)
+		})
+	}
+}
+
+func TestRimMeasuresWalls(t *testing.T) {
+	pan, err := (&SquarePanStrategy{}).Calculate(domain.Measures{Edge: floatPtr(20), Depth: floatPtr(5), WallThickness: floatPtr(0.5)})
+	require.NoError(t, err)
+	assert.Equal(t, 400.0, pan.Area)
+	assert.Equal(t, 361.0, pan.DoughArea)
+	assert.Equal(t, 380.0, pan.SideArea)
+	assert.Equal(t, 1805.0, pan.Volume)
+	assert.Equal(t, 20.0, *pan.Measures.Edge)
+}
+
+func TestRimMeasuresErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		strategy PanStrategy
+		measures domain.Measures
+		wantErr  string
+	}{
+		{
+			name:     "negative wall",
+			strategy: &RoundPanStrategy{},
+			measures: domain.Measures{Diameter: floatPtr(30), WallThickness: floatPtr(-1)},
+			wantErr:  "invalid measures: wall thickness cannot be negative",
+		},
+		{
+			name:     "wall thicker than the pan",
+			strategy: &RoundPanStrategy{},
+			measures: domain.Measures{Diameter: floatPtr(10), WallThickness: floatPtr(5)},
+			wantErr:  "invalid measures: wall thickness 5 cm leaves no room for dough",
+		},
+		{
+			name:     "border closing the ring",
+			strategy: &RingPanStrategy{},
+			measures: domain.Measures{OuterDiameter: floatPtr(30), InnerDiameter: floatPtr(10), CrustBorder: floatPtr(5)},
+			wantErr:  "invalid measures: crust border 5 cm leaves no room for topping",
+		},
+		{
+			name:     "border wider than the hexagon",
+			strategy: &RegularPolygonPanStrategy{Sides: 6},
+			measures: domain.Measures{Edge: floatPtr(10), CrustBorder: floatPtr(9)},
+			wantErr:  "invalid measures: crust border 9 cm leaves no room for topping",
+		},
+		{
+			name:     "wall collapsing a polygon edge",
+			strategy: &PolygonPanStrategy{},
+			measures: domain.Measures{
+				Vertices:      []domain.Point{{X: 0, Y: 0}, {X: 20, Y: 0}, {X: 20, Y: 2}, {X: 10, Y: 2}, {X: 10, Y: 20}, {X: 0, Y: 20}},
+				WallThickness: floatPtr(1.5),
+			},
+			wantErr: "invalid measures: wall thickness 1.5 cm leaves no room for dough",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.strategy.Calculate(tt.measures)
+			assert.ErrorIs(t, err, domain.ErrInvalidMeasures)
+			assert.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package styles
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/cfioretti/calculator/pkg/domain"
+)
+
+// Catalog is a read-only set of pizza styles looked up by ID, ignoring case.
+type Catalog struct {
+	styles map[string]domain.Style
+}
+
+var defaultCatalog = NewCatalog(
+	domain.Style{
+		ID:              "neapolitan",
+		Name:            "Neapolitan",
+		Description:     "Thin, soft centre with an airy cornicione, baked very hot",
+		ThicknessFactor: 0.35,
+		Hydration:       62,
+		Salt:            2.8,
+	},
+	domain.Style{
+		ID:              "roman_teglia",
+		Name:            "Roman teglia",
+		Description:     "High-hydration pan pizza with an open, crisp crumb",
+		ThicknessFactor: 0.55,
+		Hydration:       80,
+		Salt:            2.5,
+		Oil:             3,
+	},
+	domain.Style{
+		ID:              "detroit",
+		Name:            "Detroit",
+		Description:     "Thick, airy pan pizza with a caramelised cheese edge",
+		ThicknessFactor: 0.6,
+		Hydration:       70,
+		Salt:            2.2,
+		Oil:             1,
+	},
+	domain.Style{
+		ID:              "new_york",
+		Name:            "New York",
+		Description:     "Large, thin and foldable, baked in a deck oven",
+		ThicknessFactor: 0.37,
+		Hydration:       63,
+		Salt:            2,
+		Oil:             2.5,
+		Sugar:           1.5,
+	},
+	domain.Style{
+		ID:              "sicilian",
+		Name:            "Sicilian",
+		Description:     "Thick, spongy pan pizza with the sauce on top",
+		ThicknessFactor: 0.7,
+		Hydration:       68,
+		Salt:            2.2,
+		Oil:             4,
+		Sugar:           1,
+	},
+	domain.Style{
+		ID:              "grandma",
+		Name:            "Grandma",
+		Description:     "Thin pan pizza, stretched in an oiled sheet pan and barely proofed",
+		ThicknessFactor: 0.45,
+		Hydration:       70,
+		Salt:            2,
+		Oil:             3,
+	},
+)
+
+// Default returns the built-in styles.
+func Default() *Catalog {
+	return defaultCatalog
+}
+
+func NewCatalog(styles ...domain.Style) *Catalog {
+	c := &Catalog{styles: make(map[string]domain.Style, len(styles))}
+	for _, style := range styles {
+		c.styles[normalize(style.ID)] = style
+	}
+	return c
+}
+
+func (c *Catalog) Get(id string) (domain.Style, error) {
+	style, ok := c.styles[normalize(id)]
+	if !ok {
+		return domain.Style{}, fmt.Errorf("%w: %s", domain.ErrUnknownStyle, id)
+	}
+	return style, nil
+}
+
+// List returns every style sorted by ID.
+func (c *Catalog) List() []domain.Style {
+	styles := make([]domain.Style, 0, len(c.styles))
+	for _, style := range c.styles {
+		styles = append(styles, style)
+	}
+	sort.Slice(styles, func(i, j int) bool {
+		return styles[i].ID < styles[j].ID
+	})
+	return styles
+}
+
+func normalize(id string) string {
+	return strings.ToLower(strings.TrimSpace(id))
+}
@@ -0,0 +1,36 @@
+package styles
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cfioretti/calculator/pkg/domain"
+)
+
+func TestDefaultCatalog(t *testing.T) {
+	styles := Default().List()
+
+	ids := make([]string, 0, len(styles))
+	for _, style := range styles {
+		ids = append(ids, style.ID)
+		assert.NotEmpty(t, style.Name)
+		assert.Greater(t, style.ThicknessFactor, 0.0)
+		assert.Greater(t, style.Hydration, 0.0)
+		assert.Greater(t, style.Salt, 0.0)
+	}
+	assert.Equal(t, []string{"detroit", "grandma", "neapolitan", "new_york", "roman_teglia", "sicilian"}, ids)
+}
+
+func TestCatalogGet(t *testing.T) {
+	catalog := NewCatalog(domain.Style{ID: "neapolitan", ThicknessFactor: 0.35})
+
+	style, err := catalog.Get(" Neapolitan ")
+	require.NoError(t, err)
+	assert.Equal(t, 0.35, style.ThicknessFactor)
+
+	_, err = catalog.Get("chicago")
+	assert.ErrorIs(t, err, domain.ErrUnknownStyle)
+	assert.EqualError(t, err, "unknown style: chicago")
+}
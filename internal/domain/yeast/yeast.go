@@ -0,0 +1,81 @@
+package yeast
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/cfioretti/calculator/pkg/domain"
+)
+
+const (
+	// freshYeastHours is the fresh yeast percentage times the fermentation
+	// hours at the reference temperature: 2% ferments a dough in 2 hours.
+	freshYeastHours = 4.0
+	// referenceTemperature is the temperature, in degrees Celsius, the
+	// model is calibrated at.
+	referenceTemperature = 24.0
+	// q10 is how many times faster yeast works for every 10 °C warmer.
+	q10 = 2.5
+
+	MinHours       = 1.0
+	MaxHours       = 120.0
+	MinTemperature = 2.0
+	MaxTemperature = 35.0
+	// MaxPercentage is the most yeast a formula can carry; fermentations too
+	// short or too cold to get by with it are rejected.
+	MaxPercentage = 5.0
+)
+
+// typeFactors converts a fresh yeast weight into the equivalent weight of
+// the other yeast types.
+var typeFactors = map[domain.YeastType]float64{
+	domain.FreshYeast:     1,
+	domain.ActiveDryYeast: 0.4,
+	domain.InstantYeast:   0.33,
+}
+
+// Percentage returns the baker's percentage of yeast for a fermentation,
+// rounded to thousandths.
+func Percentage(fermentation domain.Fermentation) (float64, error) {
+	if fermentation.Hours < MinHours || fermentation.Hours > MaxHours {
+		return 0, fmt.Errorf("%w: hours must be between %g and %g", domain.ErrInvalidFermentation, MinHours, MaxHours)
+	}
+	if fermentation.Temperature < MinTemperature || fermentation.Temperature > MaxTemperature {
+		return 0, fmt.Errorf("%w: temperature must be between %g and %g °C", domain.ErrInvalidFermentation, MinTemperature, MaxTemperature)
+	}
+	factor, ok := typeFactors[fermentation.YeastType.OrDefault()]
+	if !ok {
+		return 0, fmt.Errorf("%w: unsupported yeast type %s", domain.ErrInvalidFermentation, fermentation.YeastType)
+	}
+
+	// hours at the reference temperature doing the same work
+	activity := math.Pow(q10, (fermentation.Temperature-referenceTemperature)/10)
+	fresh := freshYeastHours / (fermentation.Hours * activity)
+	percentage := math.Round(fresh*factor*1000) / 1000
+	if percentage > MaxPercentage {
+		return 0, fmt.Errorf("%w: %g hours at %g °C needs %g%% yeast, more than the %g%% allowed",
+			domain.ErrInvalidFermentation, fermentation.Hours, fermentation.Temperature, percentage, MaxPercentage)
+	}
+	return percentage, nil
+}
+
+// Convert returns the weight of a yeast type equivalent to a fresh yeast
+// weight.
+func Convert(fresh float64, yeastType domain.YeastType) float64 {
+	factor, ok := typeFactors[yeastType.OrDefault()]
+	if !ok {
+		return fresh
+	}
+	return fresh * factor
+}
+
+// Formula sets the yeast of a formula from a fermentation.
+func Formula(formula domain.Formula, fermentation domain.Fermentation) (domain.Formula, error) {
+	percentage, err := Percentage(fermentation)
+	if err != nil {
+		return domain.Formula{}, err
+	}
+	formula.Yeast = percentage
+	formula.YeastType = fermentation.YeastType.OrDefault()
+	return formula, nil
+}
@@ -0,0 +1,66 @@
+package yeast
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cfioretti/calculator/pkg/domain"
+)
+
+func TestPercentage(t *testing.T) {
+	tests := []struct {
+		name         string
+		fermentation domain.Fermentation
+		expected     float64
+	}{
+		{"two hours at room temperature", domain.Fermentation{Hours: 2, Temperature: 24}, 2},
+		{"eight hours at room temperature", domain.Fermentation{Hours: 8, Temperature: 24, YeastType: domain.FreshYeast}, 0.5},
+		{"cold retard", domain.Fermentation{Hours: 24, Temperature: 4}, 1.042},
+		{"warm proof", domain.Fermentation{Hours: 8, Temperature: 34}, 0.2},
+		{"active dry", domain.Fermentation{Hours: 8, Temperature: 24, YeastType: domain.ActiveDryYeast}, 0.2},
+		{"instant", domain.Fermentation{Hours: 8, Temperature: 24, YeastType: domain.InstantYeast}, 0.165},
+		{"instant makes a cold short rise possible", domain.Fermentation{Hours: 4, Temperature: 4, YeastType: domain.InstantYeast}, 2.063},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			percentage, err := Percentage(tt.fermentation)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, percentage)
+		})
+	}
+}
+
+func TestPercentageLongerIsLess(t *testing.T) {
+	short, err := Percentage(domain.Fermentation{Hours: 6, Temperature: 20})
+	require.NoError(t, err)
+	long, err := Percentage(domain.Fermentation{Hours: 48, Temperature: 20})
+	require.NoError(t, err)
+	assert.Less(t, long, short)
+}
+
+func TestPercentageInvalid(t *testing.T) {
+	tests := []domain.Fermentation{
+		{Hours: 0.5, Temperature: 24},
+		{Hours: 200, Temperature: 24},
+		{Hours: 8, Temperature: -5},
+		{Hours: 8, Temperature: 40},
+		{Hours: 8, Temperature: 24, YeastType: "sourdough"},
+		// too short and too cold for any sane amount of yeast
+		{Hours: 1, Temperature: 2},
+		{Hours: 4, Temperature: 4},
+	}
+	for _, fermentation := range tests {
+		_, err := Percentage(fermentation)
+		assert.ErrorIs(t, err, domain.ErrInvalidFermentation)
+	}
+}
+
+func TestFormula(t *testing.T) {
+	formula, err := Formula(domain.DefaultFormula, domain.Fermentation{Hours: 8, Temperature: 24, YeastType: domain.InstantYeast})
+	require.NoError(t, err)
+	assert.Equal(t, 0.165, formula.Yeast)
+	assert.Equal(t, domain.InstantYeast, formula.YeastType)
+	assert.Equal(t, domain.DefaultFormula.Hydration, formula.Hydration)
+}
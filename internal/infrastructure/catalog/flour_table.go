@@ -0,0 +1,33 @@
+package catalog
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/cfioretti/calculator/pkg/domain"
+)
+
+type flourTableFile struct {
+	Flours []domain.Flour `json:"flours"`
+}
+
+// ReadFlourTableFile reads flours from a JSON file shaped as {"flours": [...]}.
+func ReadFlourTableFile(path string) ([]domain.Flour, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading flour table: %w", err)
+	}
+
+	var file flourTableFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("parsing flour table %s: %w", path, err)
+	}
+
+	for i, flour := range file.Flours {
+		if flour.ID == "" {
+			return nil, fmt.Errorf("parsing flour table %s: flour %d has no id", path, i)
+		}
+	}
+	return file.Flours, nil
+}
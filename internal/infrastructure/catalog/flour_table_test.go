@@ -0,0 +1,37 @@
+package catalog
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadFlourTableFile(t *testing.T) {
+	dir := t.TempDir()
+
+	valid := filepath.Join(dir, "valid.json")
+	require.NoError(t, os.WriteFile(valid, []byte(`{"flours": [{"id": "buckwheat", "name": "Buckwheat", "absorption": 8}]}`), 0o600))
+
+	flours, err := ReadFlourTableFile(valid)
+	require.NoError(t, err)
+	require.Len(t, flours, 1)
+	assert.Equal(t, "buckwheat", flours[0].ID)
+	assert.Equal(t, 8.0, flours[0].Absorption)
+
+	missingID := filepath.Join(dir, "missing_id.json")
+	require.NoError(t, os.WriteFile(missingID, []byte(`{"flours": [{"name": "Buckwheat"}]}`), 0o600))
+	_, err = ReadFlourTableFile(missingID)
+	assert.Error(t, err)
+
+	_, err = ReadFlourTableFile(filepath.Join(dir, "missing.json"))
+	assert.Error(t, err)
+}
+
+func TestBundledFlourTable(t *testing.T) {
+	flours, err := ReadFlourTableFile("../../../configs/flours.json")
+	require.NoError(t, err)
+	assert.NotEmpty(t, flours)
+}
@@ -0,0 +1,101 @@
+package catalog
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+
+	"github.com/cfioretti/calculator/pkg/domain"
+)
+
+// InMemoryPanCatalog keeps pan presets in memory. Changes made through the
+// CRUD methods are not written back to the file the presets came from.
+type InMemoryPanCatalog struct {
+	mu   sync.RWMutex
+	pans map[string]domain.CatalogPan
+}
+
+type catalogFile struct {
+	Pans []domain.CatalogPan `json:"pans"`
+}
+
+func NewInMemoryPanCatalog() *InMemoryPanCatalog {
+	return &InMemoryPanCatalog{
+		pans: make(map[string]domain.CatalogPan),
+	}
+}
+
+// ReadPanCatalogFile reads presets from a JSON file shaped as {"pans": [...]}.
+func ReadPanCatalogFile(path string) ([]domain.CatalogPan, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading pan catalog: %w", err)
+	}
+
+	var file catalogFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("parsing pan catalog %s: %w", path, err)
+	}
+
+	return file.Pans, nil
+}
+
+func (c *InMemoryPanCatalog) Get(id string) (domain.CatalogPan, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	pan, ok := c.pans[id]
+	if !ok {
+		return domain.CatalogPan{}, fmt.Errorf("%w: %s", domain.ErrCatalogPanNotFound, id)
+	}
+	return pan, nil
+}
+
+func (c *InMemoryPanCatalog) List() []domain.CatalogPan {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	pans := make([]domain.CatalogPan, 0, len(c.pans))
+	for _, pan := range c.pans {
+		pans = append(pans, pan)
+	}
+	sort.Slice(pans, func(i, j int) bool {
+		return pans[i].ID < pans[j].ID
+	})
+	return pans
+}
+
+func (c *InMemoryPanCatalog) Create(pan domain.CatalogPan) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.pans[pan.ID]; ok {
+		return fmt.Errorf("%w: %s", domain.ErrCatalogPanExists, pan.ID)
+	}
+	c.pans[pan.ID] = pan
+	return nil
+}
+
+func (c *InMemoryPanCatalog) Update(pan domain.CatalogPan) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.pans[pan.ID]; !ok {
+		return fmt.Errorf("%w: %s", domain.ErrCatalogPanNotFound, pan.ID)
+	}
+	c.pans[pan.ID] = pan
+	return nil
+}
+
+func (c *InMemoryPanCatalog) Delete(id string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.pans[id]; !ok {
+		return fmt.Errorf("%w: %s", domain.ErrCatalogPanNotFound, id)
+	}
+	delete(c.pans, id)
+	return nil
+}
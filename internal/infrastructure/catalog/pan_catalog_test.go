@@ -0,0 +1,106 @@
+package catalog
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cfioretti/calculator/pkg/application"
+	"github.com/cfioretti/calculator/pkg/domain"
+	"github.com/cfioretti/calculator/pkg/shapes"
+)
+
+func TestInMemoryPanCatalog(t *testing.T) {
+	diameter := 33.0
+	napoli := domain.CatalogPan{ID: "napoli-33", Shape: "round", Measures: domain.Measures{Diameter: &diameter}}
+
+	c := NewInMemoryPanCatalog()
+
+	require.NoError(t, c.Create(napoli))
+	assert.ErrorIs(t, c.Create(napoli), domain.ErrCatalogPanExists)
+
+	pan, err := c.Get("napoli-33")
+	require.NoError(t, err)
+	assert.Equal(t, napoli, pan)
+
+	napoli.Description = "Neapolitan"
+	require.NoError(t, c.Update(napoli))
+	pan, _ = c.Get("napoli-33")
+	assert.Equal(t, "Neapolitan", pan.Description)
+
+	assert.ErrorIs(t, c.Update(domain.CatalogPan{ID: "missing"}), domain.ErrCatalogPanNotFound)
+
+	require.NoError(t, c.Create(domain.CatalogPan{ID: "detroit-10x14", Shape: "rectangular"}))
+	pans := c.List()
+	require.Len(t, pans, 2)
+	assert.Equal(t, "detroit-10x14", pans[0].ID)
+
+	require.NoError(t, c.Delete("napoli-33"))
+	assert.ErrorIs(t, c.Delete("napoli-33"), domain.ErrCatalogPanNotFound)
+	_, err = c.Get("napoli-33")
+	assert.ErrorIs(t, err, domain.ErrCatalogPanNotFound)
+}
+
+func TestReadPanCatalogFile(t *testing.T) {
+	dir := t.TempDir()
+
+	valid := filepath.Join(dir, "valid.json")
+	require.NoError(t, os.WriteFile(valid, []byte(`{"pans": [{"id": "detroit-10x14", "shape": "rectangular", "measures": {"width": 10, "length": 14, "unit": "in"}}]}`), 0o600))
+
+	pans, err := ReadPanCatalogFile(valid)
+	require.NoError(t, err)
+	require.Len(t, pans, 1)
+	assert.Equal(t, "detroit-10x14", pans[0].ID)
+	assert.Equal(t, 14.0, *pans[0].Measures.Length)
+	assert.Equal(t, domain.Inches, pans[0].Measures.Unit)
+
+	malformed := filepath.Join(dir, "malformed.json")
+	require.NoError(t, os.WriteFile(malformed, []byte(`{"pans": [`), 0o600))
+	_, err = ReadPanCatalogFile(malformed)
+	assert.Error(t, err)
+
+	_, err = ReadPanCatalogFile(filepath.Join(dir, "missing.json"))
+	assert.Error(t, err)
+}
+
+func TestBundledPanCatalog(t *testing.T) {
+	pans, err := ReadPanCatalogFile("../../../configs/pan_catalog.json")
+	require.NoError(t, err)
+	assert.NotEmpty(t, pans)
+}
+
+func TestBundledPanCatalogCalculates(t *testing.T) {
+	pans, err := ReadPanCatalogFile("../../../configs/pan_catalog.json")
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	panCatalog := NewInMemoryPanCatalog()
+	catalogService := application.NewPanCatalogService(panCatalog, shapes.Default())
+	for _, pan := range pans {
+		_, err := catalogService.CreatePan(ctx, pan)
+		require.NoError(t, err, pan.ID)
+	}
+	calculator := application.NewCalculatorService(application.WithPanCatalog(panCatalog))
+
+	// the depth of the preset sizes the volume only: 10 x 14 in of base at
+	// the Detroit thickness factor
+	result, err := calculator.TotalDoughWeightByPans(ctx, domain.Pans{
+		StyleID: "detroit",
+		Pans:    []domain.Pan{{CatalogID: "detroit-10x14"}},
+	})
+	require.NoError(t, err)
+	require.Len(t, result.Pans, 1)
+	assert.Equal(t, "rectangular 10 x 14 in, depth 2.5 in", result.Pans[0].Name)
+	assert.Equal(t, 541.93, result.Pans[0].DoughWeight)
+	assert.Empty(t, result.Pans[0].Warnings)
+
+	for _, pan := range pans {
+		result, err := calculator.TotalDoughWeightByPans(ctx, domain.Pans{Pans: []domain.Pan{{CatalogID: pan.ID}}})
+		require.NoError(t, err, pan.ID)
+		assert.Positive(t, result.Pans[0].DoughWeight, pan.ID)
+	}
+}
@@ -123,6 +123,7 @@ func (m *MetricsMiddleware) StreamServerInterceptor() grpc.StreamServerIntercept
 
 func isCalculationMethod(fullMethod string) bool {
 	calculationMethods := []string{
+		"/calculator.DoughCalculator/TotalDoughWeightByPans",
 		"/calculator.CalculatorService/CalculateDough",
 		"/calculator.CalculatorService/CalculateIngredients",
 		"/calculator.CalculatorService/OptimizeRecipe",
@@ -191,6 +192,10 @@ func getStatusCode(err error) string {
 			return "invalid_argument"
 		case codes.NotFound:
 			return "not_found"
+		case codes.AlreadyExists:
+			return "already_exists"
+		case codes.Unimplemented:
+			return "unimplemented"
 		case codes.Internal:
 			return "internal_error"
 		case codes.Unavailable:
@@ -205,7 +210,7 @@ func getStatusCode(err error) string {
 
 func getCalculationType(fullMethod string) string {
 	switch fullMethod {
-	case "/calculator.CalculatorService/CalculateDough":
+	case "/calculator.DoughCalculator/TotalDoughWeightByPans", "/calculator.CalculatorService/CalculateDough":
 		return "dough_calculation"
 	case "/calculator.CalculatorService/CalculateIngredients":
 		return "ingredient_calculation"
@@ -174,6 +174,11 @@ func TestIsCalculationMethod(t *testing.T) {
 			fullMethod: "/calculator.CalculatorService/CalculateDough",
 			expected:   true,
 		},
+		{
+			name:       "Pans calculation method",
+			fullMethod: "/calculator.DoughCalculator/TotalDoughWeightByPans",
+			expected:   true,
+		},
 		{
 			name:       "Ingredient calculation method",
 			fullMethod: "/calculator.CalculatorService/CalculateIngredients",
@@ -228,6 +233,16 @@ func TestGetStatusCode(t *testing.T) {
 			err:      status.Error(codes.NotFound, "recipe not found"),
 			expected: "not_found",
 		},
+		{
+			name:     "Already exists error",
+			err:      status.Error(codes.AlreadyExists, "catalog pan already exists"),
+			expected: "already_exists",
+		},
+		{
+			name:     "Unimplemented error",
+			err:      status.Error(codes.Unimplemented, "pan catalog is not configured"),
+			expected: "unimplemented",
+		},
 		{
 			name:     "Internal error",
 			err:      status.Error(codes.Internal, "calculation failed"),
@@ -267,6 +282,11 @@ func TestGetCalculationType(t *testing.T) {
 			fullMethod: "/calculator.CalculatorService/CalculateDough",
 			expected:   "dough_calculation",
 		},
+		{
+			name:       "Pans calculation",
+			fullMethod: "/calculator.DoughCalculator/TotalDoughWeightByPans",
+			expected:   "dough_calculation",
+		},
 		{
 			name:       "Ingredient calculation",
 			fullMethod: "/calculator.CalculatorService/CalculateIngredients",
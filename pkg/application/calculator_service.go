@@ -2,16 +2,70 @@ package application
 
 import (
 	"context"
-	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
 
-	"github.com/cfioretti/calculator/internal/domain/strategies"
+	"github.com/cfioretti/calculator/internal/domain/flours"
+	"github.com/cfioretti/calculator/internal/domain/preferment"
+	_ "github.com/cfioretti/calculator/internal/domain/strategies"
+	"github.com/cfioretti/calculator/internal/domain/styles"
+	"github.com/cfioretti/calculator/internal/domain/yeast"
 	"github.com/cfioretti/calculator/pkg/domain"
+	"github.com/cfioretti/calculator/pkg/shapes"
 )
 
-type DoughCalculatorService struct{}
+type DoughCalculatorService struct {
+	registry *shapes.Registry
+	catalog  domain.PanCatalog
+	styles   domain.StyleCatalog
+	flours   domain.FlourTable
+}
+
+type Option func(*DoughCalculatorService)
+
+// WithShapeRegistry resolves shapes through the given registry instead of
+// the default one holding the built-in shapes.
+func WithShapeRegistry(registry *shapes.Registry) Option {
+	return func(dc *DoughCalculatorService) {
+		dc.registry = registry
+	}
+}
+
+// WithPanCatalog lets pans reference catalog presets by ID.
+func WithPanCatalog(catalog domain.PanCatalog) Option {
+	return func(dc *DoughCalculatorService) {
+		dc.catalog = catalog
+	}
+}
+
+// WithStyleCatalog resolves style IDs through the given catalog instead of
+// the built-in styles.
+func WithStyleCatalog(styleCatalog domain.StyleCatalog) Option {
+	return func(dc *DoughCalculatorService) {
+		dc.styles = styleCatalog
+	}
+}
+
+// WithFlourTable resolves the flours of a blend through the given table
+// instead of the built-in flours.
+func WithFlourTable(flourTable domain.FlourTable) Option {
+	return func(dc *DoughCalculatorService) {
+		dc.flours = flourTable
+	}
+}
 
-func NewCalculatorService() *DoughCalculatorService {
-	return &DoughCalculatorService{}
+func NewCalculatorService(opts ...Option) *DoughCalculatorService {
+	dc := &DoughCalculatorService{
+		registry: shapes.Default(),
+		styles:   styles.Default(),
+		flours:   flours.Default(),
+	}
+	for _, opt := range opts {
+		opt(dc)
+	}
+	return dc
 }
 
 type Input struct {
@@ -24,20 +78,326 @@ type PanInput struct {
 }
 
 func (dc DoughCalculatorService) TotalDoughWeightByPans(ctx context.Context, body domain.Pans) (*domain.Pans, error) {
-	var result domain.Pans
-	for _, item := range body.Pans {
-		strategy, err := strategies.GetStrategy(item.Shape)
+	var style *domain.Style
+	if body.StyleID != "" {
+		resolved, err := dc.styles.Get(body.StyleID)
 		if err != nil {
-			return nil, errors.New("unsupported shape")
+			return nil, err
 		}
+		style = &resolved
+	}
+
+	thicknessFactor := body.ThicknessFactor
+	if thicknessFactor <= 0 && style != nil {
+		thicknessFactor = style.ThicknessFactor
+	}
+	if thicknessFactor <= 0 {
+		thicknessFactor = domain.DefaultThicknessFactor
+	}
+
+	riseFactor := body.RiseFactor
+	if riseFactor <= 0 {
+		riseFactor = domain.DefaultRiseFactor
+	}
+
+	wasteFactor := body.WasteFactor
+	if wasteFactor <= 0 {
+		wasteFactor = domain.DefaultWasteFactor
+	}
+	scalePrecision := body.ScalePrecision
+	if scalePrecision <= 0 {
+		scalePrecision = domain.DefaultScalePrecision
+	}
 
-		pan, err := strategy.Calculate(item.Measures)
+	formula := domain.DefaultFormula
+	if style != nil {
+		formula = style.Formula()
+	}
+	if body.Fermentation != nil {
+		fermented, err := yeast.Formula(formula, *body.Fermentation)
 		if err != nil {
-			return nil, errors.New("error processing pan")
+			return nil, err
 		}
+		formula = fermented
+	}
+	if body.Preferment != nil && body.Preferment.Type == domain.Levain && body.Fermentation == nil {
+		// a levain leavens the dough on its own unless the request asks
+		// for commercial yeast
+		formula.Yeast = 0
+	}
+	formula = body.FormulaOverrides.Apply(formula)
+
+	var blend []domain.BlendFlour
+	var hydrationAdjustment float64
+	if len(body.Blend) > 0 {
+		resolved, adjustment, err := flours.Blend(dc.flours, body.Blend)
+		if err != nil {
+			return nil, err
+		}
+		blend, hydrationAdjustment = resolved, adjustment
+		formula.Hydration = roundHundredths(formula.Hydration + adjustment)
+	}
+	if err := formula.Validate(); err != nil {
+		return nil, err
+	}
+
+	var prefermentSpec *domain.Preferment
+	if body.Preferment != nil {
+		resolved, err := preferment.Resolve(formula, *body.Preferment)
+		if err != nil {
+			return nil, err
+		}
+		prefermentSpec = &resolved
+	}
+
+	unit := body.Unit.OrDefault()
+
+	result := domain.Pans{
+		ThicknessFactor: thicknessFactor,
+		RiseFactor:      riseFactor,
+		Unit:            unit,
+		Partial:         body.Partial,
+		Strict:          body.Strict,
+		StyleID:         body.StyleID,
+		Style:           style,
+		Formula:         formula,
+		Fermentation:    body.Fermentation,
+		Preferment:      prefermentSpec,
+		Blend:           body.Blend,
+
+		HydrationAdjustment: hydrationAdjustment,
+		WasteFactor:         wasteFactor,
+		ScalePrecision:      scalePrecision,
+	}
+	for i, item := range body.Pans {
+		pan, err := dc.calculatePan(item, unit, body.Strict)
+		if err == nil {
+			doughArea := pan.DoughArea
+			if pan.DoughOnWalls {
+				doughArea += pan.SideArea
+			}
+			doughArea = pan.Measures.Unit.ToSquareCentimeters(doughArea)
+			pan.DoughWeight = doughWeight(doughArea, thicknessFactor)
+			pan.BallWeight, err = ballWeight(pan.DoughWeight, pan.BallCount, wasteFactor, scalePrecision)
+		}
+		if err != nil {
+			panErr := domain.PanError{Index: i, Err: err}
+			if !body.Partial {
+				return nil, &panErr
+			}
+			result.Pans = append(result.Pans, failedPan(item))
+			result.Errors = append(result.Errors, panErr)
+			continue
+		}
+
+		if warning := overflowWarning(pan, riseFactor); warning != "" {
+			pan.Warnings = append(pan.Warnings, warning)
+		}
+		pan = toRequestUnit(pan, unit)
+		pan.Ingredients = formula.Ingredients(mixWeight(pan.DoughWeight*wasteFactor, pan.BallWeight*float64(pan.BallCount)))
+
+		pan.LineArea = roundHundredths(pan.Area * float64(pan.Quantity))
+		pan.LineDoughWeight = roundHundredths(pan.DoughWeight * float64(pan.Quantity))
 
 		result.Pans = append(result.Pans, pan)
-		result.TotalArea += pan.Area
+		result.TotalArea += pan.LineArea
+		result.TotalDoughWeight += pan.LineDoughWeight
+		result.TotalBallWeight += pan.BallWeight * float64(pan.BallCount*pan.Quantity)
+	}
+	result.TotalArea = roundHundredths(result.TotalArea)
+	result.TotalDoughWeight = roundHundredths(result.TotalDoughWeight)
+	result.TotalBallWeight = roundHundredths(result.TotalBallWeight)
+	result.MixDoughWeight = mixWeight(result.TotalDoughWeight*wasteFactor, result.TotalBallWeight)
+	result.Ingredients = formula.Ingredients(result.MixDoughWeight)
+	if prefermentSpec != nil {
+		bill := preferment.Split(formula, result.Ingredients, *prefermentSpec)
+		result.PrefermentBill = &bill
+	}
+	if blend != nil {
+		result.Flours = flours.Weigh(blend, result.Ingredients.Flour)
 	}
 	return &result, nil
 }
+
+// calculatePan resolves the shape of a pan and calculates its surfaces in
+// the unit it is measured in.
+func (dc DoughCalculatorService) calculatePan(item domain.Pan, unit domain.Unit, strict bool) (domain.Pan, error) {
+	if item.CatalogID != "" {
+		if strict && (item.Shape != "" || hasMeasures(item.Measures)) {
+			return domain.Pan{}, fmt.Errorf("%w: shape and measures come from catalog pan %s", domain.ErrInvalidMeasures, item.CatalogID)
+		}
+		resolved, err := dc.resolveCatalogPan(item)
+		if err != nil {
+			return domain.Pan{}, err
+		}
+		item = resolved
+	}
+	if item.Measures.Unit == "" {
+		item.Measures.Unit = unit
+	}
+
+	quantity := item.Quantity
+	if quantity < 0 {
+		return domain.Pan{}, fmt.Errorf("%w: %d is negative", domain.ErrInvalidQuantity, quantity)
+	}
+	if quantity == 0 {
+		quantity = 1
+	}
+
+	ballCount := item.BallCount
+	if ballCount < 0 {
+		return domain.Pan{}, fmt.Errorf("%w: %d is negative", domain.ErrInvalidBallCount, ballCount)
+	}
+	if ballCount == 0 {
+		ballCount = 1
+	}
+
+	shape, err := dc.registry.Lookup(item.Shape)
+	if err != nil {
+		return domain.Pan{}, err
+	}
+	if strict && item.CatalogID == "" {
+		if err := strictMeasures(shape, item.Measures); err != nil {
+			return domain.Pan{}, err
+		}
+	}
+
+	pan, err := shape.Strategy.Calculate(item.Measures)
+	if err != nil {
+		return domain.Pan{}, err
+	}
+	// strategies that know nothing about walls and borders leave them unset
+	if pan.DoughArea == 0 {
+		pan.DoughArea = pan.Area
+	}
+	if pan.ToppingArea == 0 {
+		pan.ToppingArea = pan.DoughArea
+	}
+	pan.CatalogID = item.CatalogID
+	pan.DoughOnWalls = item.DoughOnWalls
+	pan.Quantity = quantity
+	pan.BallCount = ballCount
+	return pan, nil
+}
+
+// strictMeasures rejects the measures a shape would silently ignore.
+func strictMeasures(shape shapes.Shape, measures domain.Measures) error {
+	violations := shape.StrictViolations(measures)
+	if len(violations) == 0 {
+		return nil
+	}
+
+	descriptions := make([]string, 0, len(violations))
+	for _, violation := range violations {
+		descriptions = append(descriptions, violation.Description)
+	}
+	return fmt.Errorf("%w: %s", domain.ErrInvalidMeasures, strings.Join(descriptions, "; "))
+}
+
+func hasMeasures(measures domain.Measures) bool {
+	return len(measures.Values()) > 0 || len(measures.Vertices) > 0
+}
+
+// SupportedShapes lists the shapes the calculator can resolve.
+func (dc DoughCalculatorService) SupportedShapes(ctx context.Context) []shapes.Shape {
+	return dc.registry.Shapes()
+}
+
+// Styles lists the style profiles requests can select.
+func (dc DoughCalculatorService) Styles(ctx context.Context) []domain.Style {
+	return dc.styles.List()
+}
+
+// Flours lists the flours a blend can use.
+func (dc DoughCalculatorService) Flours(ctx context.Context) []domain.Flour {
+	return dc.flours.List()
+}
+
+// resolveCatalogPan replaces the shape and measures of a pan with the ones
+// of the catalog preset it references.
+func (dc DoughCalculatorService) resolveCatalogPan(item domain.Pan) (domain.Pan, error) {
+	if dc.catalog == nil {
+		return domain.Pan{}, domain.ErrCatalogNotConfigured
+	}
+
+	preset, err := dc.catalog.Get(item.CatalogID)
+	if err != nil {
+		return domain.Pan{}, err
+	}
+
+	item.Shape = preset.Shape
+	item.Measures = preset.Measures
+	return item, nil
+}
+
+// failedPan echoes the input of a pan that could not be calculated so that
+// partial results keep the positions of the request.
+func failedPan(item domain.Pan) domain.Pan {
+	return domain.Pan{
+		CatalogID:    item.CatalogID,
+		Shape:        item.Shape,
+		Measures:     item.Measures,
+		DoughOnWalls: item.DoughOnWalls,
+		Quantity:     item.Quantity,
+		BallCount:    item.BallCount,
+	}
+}
+
+// ballWeight divides the dough of a pan into balls, scaled up by the waste
+// factor and rounded to the precision of the scale. Balls too light for the
+// scale to weigh are rejected.
+func ballWeight(doughWeight float64, balls int, wasteFactor, precision float64) (float64, error) {
+	weight := doughWeight * wasteFactor / float64(balls)
+	steps := math.Round(weight / precision)
+	if steps < 1 {
+		return 0, fmt.Errorf("%w: %d balls of %s g round to 0 g on a scale weighing %g g steps",
+			domain.ErrInvalidBallCount, balls, strconv.FormatFloat(roundHundredths(weight), 'f', -1, 64), precision)
+	}
+	return roundHundredths(steps * precision), nil
+}
+
+// mixWeight is the dough to mix for a batch: the dough weight scaled by the
+// waste factor, and never less than the balls divided from it need.
+func mixWeight(scaledDough, balls float64) float64 {
+	return roundHundredths(math.Max(scaledDough, balls))
+}
+
+// toRequestUnit converts the surfaces of a pan measured in another unit,
+// such as a catalog preset, so that totals add up in the request unit.
+// The name keeps the unit the pan was measured in.
+func toRequestUnit(pan domain.Pan, unit domain.Unit) domain.Pan {
+	panUnit := pan.Measures.Unit.OrDefault()
+	if panUnit == unit {
+		return pan
+	}
+
+	pan.Area = roundHundredths(unit.FromSquareCentimeters(panUnit.ToSquareCentimeters(pan.Area)))
+	pan.DoughArea = roundHundredths(unit.FromSquareCentimeters(panUnit.ToSquareCentimeters(pan.DoughArea)))
+	pan.ToppingArea = roundHundredths(unit.FromSquareCentimeters(panUnit.ToSquareCentimeters(pan.ToppingArea)))
+	pan.SideArea = roundHundredths(unit.FromSquareCentimeters(panUnit.ToSquareCentimeters(pan.SideArea)))
+	pan.Volume = roundHundredths(unit.FromCubicCentimeters(panUnit.ToCubicCentimeters(pan.Volume)))
+	return pan
+}
+
+func doughWeight(area float64, thicknessFactor float64) float64 {
+	return roundHundredths(area * thicknessFactor)
+}
+
+// overflowWarning reports when the proofed dough would not fit in a pan
+// with a known volume.
+func overflowWarning(pan domain.Pan, riseFactor float64) string {
+	if pan.Volume <= 0 {
+		return ""
+	}
+
+	unit := pan.Measures.Unit.OrDefault()
+	proofedVolume := unit.FromCubicCentimeters(pan.DoughWeight / domain.DoughDensity * riseFactor)
+	if proofedVolume <= pan.Volume {
+		return ""
+	}
+	return fmt.Sprintf("proofed dough volume %.0f %s³ overflows pan volume %.0f %s³", proofedVolume, unit, pan.Volume, unit)
+}
+
+func roundHundredths(value float64) float64 {
+	return math.Round(value*100) / 100
+}
@@ -6,16 +6,22 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
+	"github.com/cfioretti/calculator/internal/domain/flours"
+	"github.com/cfioretti/calculator/internal/domain/styles"
+	"github.com/cfioretti/calculator/internal/infrastructure/catalog"
 	bdomain "github.com/cfioretti/calculator/pkg/domain"
+	"github.com/cfioretti/calculator/pkg/shapes"
 )
 
 func TestTotalDoughWeightByPans(t *testing.T) {
 	tests := []struct {
-		name     string
-		input    bdomain.Pans
-		wantArea float64
-		wantErr  bool
+		name       string
+		input      bdomain.Pans
+		wantArea   float64
+		wantWeight float64
+		wantErr    bool
 	}{
 		{
 			name: "success with single pan",
@@ -24,14 +30,32 @@ func TestTotalDoughWeightByPans(t *testing.T) {
 					{
 						Shape: "rectangular",
 						Measures: bdomain.Measures{
-							Width:  intPtr(30),
-							Length: intPtr(40),
+							Width:  floatPtr(30),
+							Length: floatPtr(40),
 						},
 					},
 				},
 			},
-			wantArea: 1200,
-			wantErr:  false,
+			wantArea:   1200,
+			wantWeight: 600,
+			wantErr:    false,
+		},
+		{
+			name: "success with custom thickness factor",
+			input: bdomain.Pans{
+				Pans: []bdomain.Pan{
+					{
+						Shape: "square",
+						Measures: bdomain.Measures{
+							Edge: floatPtr(30),
+						},
+					},
+				},
+				ThicknessFactor: 0.65,
+			},
+			wantArea:   900,
+			wantWeight: 585,
+			wantErr:    false,
 		},
 		{
 			name: "success with multiple pans",
@@ -40,35 +64,112 @@ func TestTotalDoughWeightByPans(t *testing.T) {
 					{
 						Shape: "round",
 						Measures: bdomain.Measures{
-							Diameter: intPtr(20),
+							Diameter: floatPtr(20),
 						},
 					},
 					{
 						Shape: "square",
 						Measures: bdomain.Measures{
-							Edge: intPtr(20),
+							Edge: floatPtr(20),
 						},
 					},
 				},
 			},
-			wantArea: math.Pi*100 + 400,
-			wantErr:  false,
+			wantArea:   math.Pi*100 + 400,
+			wantWeight: 357.08,
+			wantErr:    false,
+		},
+		{
+			name: "success with inches",
+			input: bdomain.Pans{
+				Pans: []bdomain.Pan{
+					{
+						Shape: "rectangular",
+						Measures: bdomain.Measures{
+							Width:  floatPtr(10),
+							Length: floatPtr(14),
+						},
+					},
+				},
+				Unit: bdomain.Inches,
+			},
+			wantArea:   140,
+			wantWeight: 451.61,
+			wantErr:    false,
+		},
+		{
+			name: "success with quantity",
+			input: bdomain.Pans{
+				Pans: []bdomain.Pan{
+					{
+						Shape: "rectangular",
+						Measures: bdomain.Measures{
+							Width:  floatPtr(30),
+							Length: floatPtr(40),
+						},
+						Quantity: 12,
+					},
+					{
+						Shape: "round",
+						Measures: bdomain.Measures{
+							Diameter: floatPtr(20),
+						},
+						Quantity: 1,
+					},
+				},
+			},
+			wantArea:   14714.16,
+			wantWeight: 7357.08,
+			wantErr:    false,
+		},
+		{
+			name: "negative quantity",
+			input: bdomain.Pans{
+				Pans: []bdomain.Pan{
+					{
+						Shape: "square",
+						Measures: bdomain.Measures{
+							Edge: floatPtr(20),
+						},
+						Quantity: -1,
+					},
+				},
+			},
+			wantErr: true,
 		},
 		{
 			name: "invalid shape",
 			input: bdomain.Pans{
 				Pans: []bdomain.Pan{
 					{
-						Shape: "triangle",
+						Shape: "star",
 						Measures: bdomain.Measures{
-							Width:  intPtr(20),
-							Length: intPtr(30),
+							Width:  floatPtr(20),
+							Length: floatPtr(30),
 						},
 					},
 				},
 			},
 			wantErr: true,
 		},
+		{
+			name: "dough weight excludes the pan walls",
+			input: bdomain.Pans{
+				Pans: []bdomain.Pan{
+					{
+						Shape: "round",
+						Measures: bdomain.Measures{
+							Diameter:      floatPtr(30),
+							WallThickness: floatPtr(0.5),
+							CrustBorder:   floatPtr(2),
+						},
+					},
+				},
+			},
+			wantArea:   706.86,
+			wantWeight: 330.26,
+			wantErr:    false,
+		},
 		{
 			name: "invalid measures",
 			input: bdomain.Pans{
@@ -88,8 +189,9 @@ func TestTotalDoughWeightByPans(t *testing.T) {
 			input: bdomain.Pans{
 				Pans: []bdomain.Pan{},
 			},
-			wantArea: 0,
-			wantErr:  false,
+			wantArea:   0,
+			wantWeight: 0,
+			wantErr:    false,
 		},
 	}
 
@@ -108,10 +210,580 @@ func TestTotalDoughWeightByPans(t *testing.T) {
 			assert.NoError(t, err)
 			assert.NotNil(t, result)
 			assert.InDelta(t, tt.wantArea, result.TotalArea, 0.001)
+			assert.InDelta(t, tt.wantWeight, result.TotalDoughWeight, 0.001)
 		})
 	}
 }
 
-func intPtr(value int) *int {
+func floatPtr(value float64) *float64 {
 	return &value
 }
+
+func TestTotalDoughWeightByPansDeepPans(t *testing.T) {
+	tests := []struct {
+		name         string
+		input        bdomain.Pans
+		wantWeight   float64
+		wantWarnings int
+	}{
+		{
+			name: "deep pan holds the proofed dough",
+			input: bdomain.Pans{
+				Pans: []bdomain.Pan{
+					{
+						Shape:        "round",
+						Measures:     bdomain.Measures{Diameter: floatPtr(20), Depth: floatPtr(5)},
+						DoughOnWalls: true,
+					},
+				},
+			},
+			wantWeight:   314.16,
+			wantWarnings: 0,
+		},
+		{
+			name: "depth alone leaves the walls bare",
+			input: bdomain.Pans{
+				Pans: []bdomain.Pan{
+					{
+						Shape:    "round",
+						Measures: bdomain.Measures{Diameter: floatPtr(20), Depth: floatPtr(5)},
+					},
+				},
+			},
+			wantWeight:   157.08,
+			wantWarnings: 0,
+		},
+		{
+			name: "shallow pan overflows",
+			input: bdomain.Pans{
+				Pans: []bdomain.Pan{
+					{
+						Shape:    "round",
+						Measures: bdomain.Measures{Diameter: floatPtr(20), Depth: floatPtr(1)},
+					},
+				},
+				RiseFactor: 2.5,
+			},
+			wantWeight:   157.08,
+			wantWarnings: 1,
+		},
+	}
+
+	calculator := NewCalculatorService()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := calculator.TotalDoughWeightByPans(context.Background(), tt.input)
+
+			assert.NoError(t, err)
+			assert.InDelta(t, tt.wantWeight, result.TotalDoughWeight, 0.01)
+			assert.Len(t, result.Pans[0].Warnings, tt.wantWarnings)
+		})
+	}
+}
+
+func TestTotalDoughWeightByPansRoundsTotalArea(t *testing.T) {
+	calculator := NewCalculatorService()
+
+	result, err := calculator.TotalDoughWeightByPans(context.Background(), bdomain.Pans{
+		Pans: []bdomain.Pan{
+			{Shape: "round", Measures: bdomain.Measures{Diameter: floatPtr(30)}},
+			{Shape: "square", Measures: bdomain.Measures{Edge: floatPtr(30)}},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1606.86, result.TotalArea)
+}
+
+func TestTotalDoughWeightByPansErrors(t *testing.T) {
+	round := bdomain.Pan{Shape: "round", Measures: bdomain.Measures{Diameter: floatPtr(30)}}
+
+	tests := []struct {
+		name      string
+		pan       bdomain.Pan
+		wantErr   error
+		wantCause string
+	}{
+		{
+			name:      "Unsupported shape",
+			pan:       bdomain.Pan{Shape: "star", Measures: bdomain.Measures{Diameter: floatPtr(30)}},
+			wantErr:   bdomain.ErrUnsupportedShape,
+			wantCause: "unsupported shape: star",
+		},
+		{
+			name:      "Invalid measures",
+			pan:       bdomain.Pan{Shape: "oval", Measures: bdomain.Measures{MajorAxis: floatPtr(20), MinorAxis: floatPtr(30)}},
+			wantErr:   bdomain.ErrInvalidMeasures,
+			wantCause: "invalid measures: minor axis cannot be longer than major axis",
+		},
+		{
+			name:      "Negative quantity",
+			pan:       bdomain.Pan{Shape: "round", Measures: bdomain.Measures{Diameter: floatPtr(30)}, Quantity: -2},
+			wantErr:   bdomain.ErrInvalidQuantity,
+			wantCause: "invalid quantity: -2 is negative",
+		},
+		{
+			name:      "Negative ball count",
+			pan:       bdomain.Pan{Shape: "round", Measures: bdomain.Measures{Diameter: floatPtr(30)}, BallCount: -1},
+			wantErr:   bdomain.ErrInvalidBallCount,
+			wantCause: "invalid ball count: -1 is negative",
+		},
+	}
+
+	calculator := NewCalculatorService()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := calculator.TotalDoughWeightByPans(context.Background(), bdomain.Pans{
+				Pans: []bdomain.Pan{round, tt.pan},
+			})
+			require.Error(t, err)
+			assert.ErrorIs(t, err, tt.wantErr)
+
+			var panErr *bdomain.PanError
+			require.ErrorAs(t, err, &panErr)
+			assert.Equal(t, 1, panErr.Index)
+			assert.EqualError(t, panErr.Err, tt.wantCause)
+		})
+	}
+}
+
+func TestTotalDoughWeightByPansPartial(t *testing.T) {
+	calculator := NewCalculatorService()
+
+	result, err := calculator.TotalDoughWeightByPans(context.Background(), bdomain.Pans{
+		Partial: true,
+		Pans: []bdomain.Pan{
+			{Shape: "square", Measures: bdomain.Measures{Edge: floatPtr(20)}},
+			{Shape: "star", Measures: bdomain.Measures{Diameter: floatPtr(30)}, Quantity: 3},
+			{Shape: "rectangular", Measures: bdomain.Measures{Width: floatPtr(10), Length: floatPtr(20)}, Quantity: 2},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, result.Pans, 3)
+	require.Len(t, result.Errors, 1)
+
+	assert.Equal(t, 1, result.Errors[0].Index)
+	assert.ErrorIs(t, result.Errors[0].Err, bdomain.ErrUnsupportedShape)
+
+	failed := result.Pans[1]
+	assert.Equal(t, "star", failed.Shape)
+	assert.Equal(t, 3, failed.Quantity)
+	assert.Zero(t, failed.LineDoughWeight)
+
+	assert.Equal(t, 800.0, result.TotalArea)
+	assert.Equal(t, 400.0, result.TotalDoughWeight)
+}
+
+func TestTotalDoughWeightByPansStrict(t *testing.T) {
+	panCatalog := catalog.NewInMemoryPanCatalog()
+	require.NoError(t, panCatalog.Create(bdomain.CatalogPan{
+		ID:       "napoli-33",
+		Shape:    "round",
+		Measures: bdomain.Measures{Diameter: floatPtr(33)},
+	}))
+	calculator := NewCalculatorService(WithPanCatalog(panCatalog))
+
+	tests := []struct {
+		name    string
+		pan     bdomain.Pan
+		wantErr string
+	}{
+		{
+			name: "Accepted measures",
+			pan:  bdomain.Pan{Shape: "square", Measures: bdomain.Measures{Edge: floatPtr(20), Depth: floatPtr(3)}},
+		},
+		{
+			name:    "Unexpected measure",
+			pan:     bdomain.Pan{Shape: "square", Measures: bdomain.Measures{Edge: floatPtr(20), Diameter: floatPtr(20)}},
+			wantErr: "pan 0: invalid measures: diameter is not a measure of shape square",
+		},
+		{
+			name:    "Conflicting measures",
+			pan:     bdomain.Pan{Shape: "hexagon", Measures: bdomain.Measures{Edge: floatPtr(10), Circumradius: floatPtr(10)}},
+			wantErr: "pan 0: invalid measures: circumradius cannot be given together with edge; edge cannot be given together with circumradius",
+		},
+		{
+			name: "Catalog pan",
+			pan:  bdomain.Pan{CatalogID: "napoli-33"},
+		},
+		{
+			name:    "Catalog pan with measures",
+			pan:     bdomain.Pan{CatalogID: "napoli-33", Measures: bdomain.Measures{Diameter: floatPtr(28)}},
+			wantErr: "pan 0: invalid measures: shape and measures come from catalog pan napoli-33",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := calculator.TotalDoughWeightByPans(context.Background(), bdomain.Pans{
+				Strict: true,
+				Pans:   []bdomain.Pan{tt.pan},
+			})
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, bdomain.ErrInvalidMeasures)
+			assert.EqualError(t, err, tt.wantErr)
+		})
+	}
+
+	_, err := calculator.TotalDoughWeightByPans(context.Background(), bdomain.Pans{
+		Pans: []bdomain.Pan{{Shape: "square", Measures: bdomain.Measures{Edge: floatPtr(20), Diameter: floatPtr(20)}}},
+	})
+	assert.NoError(t, err, "measures are ignored outside strict mode")
+}
+
+func TestTotalDoughWeightByPansStyle(t *testing.T) {
+	calculator := NewCalculatorService(WithStyleCatalog(styles.NewCatalog(
+		bdomain.Style{ID: "neapolitan", ThicknessFactor: 0.35, Hydration: 62, Salt: 2.8},
+	)))
+	pans := []bdomain.Pan{{Shape: "square", Measures: bdomain.Measures{Edge: floatPtr(20)}}}
+
+	tests := []struct {
+		name                string
+		input               bdomain.Pans
+		wantThicknessFactor float64
+		wantWeight          float64
+		wantStyle           string
+	}{
+		{
+			name:                "No style",
+			input:               bdomain.Pans{Pans: pans},
+			wantThicknessFactor: bdomain.DefaultThicknessFactor,
+			wantWeight:          200,
+		},
+		{
+			name:                "Style thickness factor",
+			input:               bdomain.Pans{Pans: pans, StyleID: "neapolitan"},
+			wantThicknessFactor: 0.35,
+			wantWeight:          140,
+			wantStyle:           "neapolitan",
+		},
+		{
+			name:                "Explicit thickness factor wins",
+			input:               bdomain.Pans{Pans: pans, StyleID: "neapolitan", ThicknessFactor: 0.6},
+			wantThicknessFactor: 0.6,
+			wantWeight:          240,
+			wantStyle:           "neapolitan",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := calculator.TotalDoughWeightByPans(context.Background(), tt.input)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantThicknessFactor, result.ThicknessFactor)
+			assert.Equal(t, tt.wantWeight, result.TotalDoughWeight)
+			if tt.wantStyle == "" {
+				assert.Nil(t, result.Style)
+				return
+			}
+			require.NotNil(t, result.Style)
+			assert.Equal(t, tt.wantStyle, result.Style.ID)
+		})
+	}
+
+	_, err := calculator.TotalDoughWeightByPans(context.Background(), bdomain.Pans{Pans: pans, StyleID: "detroit"})
+	assert.ErrorIs(t, err, bdomain.ErrUnknownStyle)
+}
+
+func TestTotalDoughWeightByPansIngredients(t *testing.T) {
+	calculator := NewCalculatorService(WithStyleCatalog(styles.NewCatalog(
+		bdomain.Style{ID: "test", ThicknessFactor: 0.5, Hydration: 70, Salt: 3, Oil: 3, Sugar: 3},
+	)))
+
+	yeast := 1.0
+	result, err := calculator.TotalDoughWeightByPans(context.Background(), bdomain.Pans{
+		StyleID:          "test",
+		FormulaOverrides: bdomain.FormulaOverrides{Yeast: &yeast},
+		Pans: []bdomain.Pan{
+			{Shape: "rectangular", Measures: bdomain.Measures{Width: floatPtr(30), Length: floatPtr(40)}},
+			{Shape: "rectangular", Measures: bdomain.Measures{Width: floatPtr(20), Length: floatPtr(30)}, Quantity: 2},
+		},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, bdomain.Formula{Hydration: 70, Salt: 3, Yeast: 1, YeastType: bdomain.FreshYeast, Oil: 3, Sugar: 3}, result.Formula)
+	assert.Equal(t, bdomain.Ingredients{Flour: 333.33, Water: 233.33, Salt: 10, Yeast: 3.33, Oil: 10, Sugar: 10}, result.Pans[0].Ingredients)
+	assert.Equal(t, bdomain.Ingredients{Flour: 166.67, Water: 116.67, Salt: 5, Yeast: 1.67, Oil: 5, Sugar: 5}, result.Pans[1].Ingredients)
+	assert.Equal(t, 1200.0, result.TotalDoughWeight)
+	assert.Equal(t, bdomain.Ingredients{Flour: 666.67, Water: 466.67, Salt: 20, Yeast: 6.67, Oil: 20, Sugar: 20}, result.Ingredients)
+
+	negative := -1.0
+	_, err = calculator.TotalDoughWeightByPans(context.Background(), bdomain.Pans{
+		FormulaOverrides: bdomain.FormulaOverrides{Salt: &negative},
+	})
+	assert.ErrorIs(t, err, bdomain.ErrInvalidFormula)
+
+	nan := math.NaN()
+	_, err = calculator.TotalDoughWeightByPans(context.Background(), bdomain.Pans{
+		FormulaOverrides: bdomain.FormulaOverrides{Hydration: &nan},
+	})
+	assert.ErrorIs(t, err, bdomain.ErrInvalidFormula)
+}
+
+func TestTotalDoughWeightByPansFermentation(t *testing.T) {
+	calculator := NewCalculatorService()
+	pans := []bdomain.Pan{{Shape: "rectangular", Measures: bdomain.Measures{Width: floatPtr(30), Length: floatPtr(40)}}}
+	fermentation := &bdomain.Fermentation{Hours: 8, Temperature: 24, YeastType: bdomain.InstantYeast}
+
+	result, err := calculator.TotalDoughWeightByPans(context.Background(), bdomain.Pans{Pans: pans, Fermentation: fermentation})
+	require.NoError(t, err)
+	assert.Equal(t, 0.165, result.Formula.Yeast)
+	assert.Equal(t, bdomain.InstantYeast, result.Formula.YeastType)
+
+	yeast := 1.0
+	result, err = calculator.TotalDoughWeightByPans(context.Background(), bdomain.Pans{
+		Pans:             pans,
+		Fermentation:     fermentation,
+		FormulaOverrides: bdomain.FormulaOverrides{Yeast: &yeast},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1.0, result.Formula.Yeast)
+	assert.Equal(t, bdomain.InstantYeast, result.Formula.YeastType)
+
+	_, err = calculator.TotalDoughWeightByPans(context.Background(), bdomain.Pans{
+		Pans:         pans,
+		Fermentation: &bdomain.Fermentation{Hours: 500, Temperature: 24},
+	})
+	assert.ErrorIs(t, err, bdomain.ErrInvalidFermentation)
+}
+
+func TestTotalDoughWeightByPansPreferment(t *testing.T) {
+	calculator := NewCalculatorService()
+	pans := []bdomain.Pan{{Shape: "rectangular", Measures: bdomain.Measures{Width: floatPtr(30), Length: floatPtr(40)}, Quantity: 2}}
+
+	result, err := calculator.TotalDoughWeightByPans(context.Background(), bdomain.Pans{
+		Pans:       pans,
+		Preferment: &bdomain.Preferment{Type: bdomain.Biga, FlourShare: 50},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, result.Preferment)
+	assert.Equal(t, 50.0, result.Preferment.Hydration)
+	require.NotNil(t, result.PrefermentBill)
+
+	pre, final := result.PrefermentBill.Preferment, result.PrefermentBill.FinalDough
+	assert.InDelta(t, result.Ingredients.Flour, pre.Flour+final.Flour, 1e-9)
+	assert.InDelta(t, result.Ingredients.Water, pre.Water+final.Water, 1e-9)
+	assert.InDelta(t, result.Ingredients.Yeast, pre.Yeast+final.Yeast, 1e-9)
+	assert.Equal(t, result.Ingredients.Salt, final.Salt)
+	assert.Zero(t, pre.Salt)
+
+	_, err = calculator.TotalDoughWeightByPans(context.Background(), bdomain.Pans{
+		Pans:       pans,
+		Preferment: &bdomain.Preferment{Type: bdomain.Poolish, FlourShare: 90},
+	})
+	assert.ErrorIs(t, err, bdomain.ErrInvalidPreferment)
+}
+
+func TestTotalDoughWeightByPansFlourBlend(t *testing.T) {
+	calculator := NewCalculatorService(WithFlourTable(flours.Default().With(bdomain.Flour{ID: "buckwheat", Absorption: 8})))
+	pans := []bdomain.Pan{{Shape: "rectangular", Measures: bdomain.Measures{Width: floatPtr(30), Length: floatPtr(40)}}}
+
+	result, err := calculator.TotalDoughWeightByPans(context.Background(), bdomain.Pans{
+		Pans:  pans,
+		Blend: []bdomain.BlendPart{{FlourID: "tipo_00", Share: 75}, {FlourID: "buckwheat", Share: 25}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2.0, result.HydrationAdjustment)
+	assert.Equal(t, 67.0, result.Formula.Hydration)
+	require.Len(t, result.Flours, 2)
+	assert.Equal(t, "buckwheat", result.Flours[1].Flour.ID)
+	assert.InDelta(t, result.Ingredients.Flour, result.Flours[0].Weight+result.Flours[1].Weight, 1e-9)
+
+	_, err = calculator.TotalDoughWeightByPans(context.Background(), bdomain.Pans{
+		Pans:  pans,
+		Blend: []bdomain.BlendPart{{FlourID: "cassava", Share: 100}},
+	})
+	assert.ErrorIs(t, err, bdomain.ErrUnknownFlour)
+}
+
+func TestTotalDoughWeightByPansBalls(t *testing.T) {
+	calculator := NewCalculatorService()
+	teglia := bdomain.Measures{Width: floatPtr(30), Length: floatPtr(40)}
+
+	result, err := calculator.TotalDoughWeightByPans(context.Background(), bdomain.Pans{
+		Pans: []bdomain.Pan{{Shape: "rectangular", Measures: teglia}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, bdomain.DefaultWasteFactor, result.WasteFactor)
+	assert.Equal(t, bdomain.DefaultScalePrecision, result.ScalePrecision)
+	assert.Equal(t, 1, result.Pans[0].BallCount)
+	assert.Equal(t, 600.0, result.Pans[0].BallWeight)
+	assert.Equal(t, 600.0, result.TotalBallWeight)
+
+	result, err = calculator.TotalDoughWeightByPans(context.Background(), bdomain.Pans{
+		WasteFactor:    1.03,
+		ScalePrecision: 5,
+		Pans: []bdomain.Pan{
+			{Shape: "rectangular", Measures: teglia, Quantity: 2, BallCount: 2},
+			{Shape: "round", Measures: bdomain.Measures{Diameter: floatPtr(30)}},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.Pans[0].BallCount)
+	// 600 g scaled by 1.03 and split in two is 309 g, rounded to 310 g
+	assert.Equal(t, 310.0, result.Pans[0].BallWeight)
+	// 353.43 g scaled by 1.03 is 364.03 g, rounded to 365 g
+	assert.Equal(t, 365.0, result.Pans[1].BallWeight)
+	assert.Equal(t, 310.0*4+365, result.TotalBallWeight)
+
+	// the dough to mix covers the balls, and the bill covers the dough to mix
+	assert.Equal(t, 1605.0, result.MixDoughWeight)
+	assert.GreaterOrEqual(t, result.MixDoughWeight, result.TotalBallWeight)
+	assert.InDelta(t, result.MixDoughWeight, ingredientsWeight(result.Ingredients), 0.05)
+	assert.InDelta(t, 620.0, ingredientsWeight(result.Pans[0].Ingredients), 0.05)
+}
+
+func TestTotalDoughWeightByPansBallsScaleBillWithWaste(t *testing.T) {
+	calculator := NewCalculatorService()
+
+	result, err := calculator.TotalDoughWeightByPans(context.Background(), bdomain.Pans{
+		WasteFactor: 1.05,
+		Preferment:  &bdomain.Preferment{Type: bdomain.Biga, FlourShare: 50},
+		Blend:       []bdomain.BlendPart{{FlourID: "tipo_00", Share: 100}},
+		Pans:        []bdomain.Pan{{Shape: "rectangular", Measures: bdomain.Measures{Width: floatPtr(30), Length: floatPtr(40)}, Quantity: 3}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1800.0, result.TotalDoughWeight)
+	assert.Equal(t, 1890.0, result.TotalBallWeight)
+	assert.Equal(t, 1890.0, result.MixDoughWeight)
+	assert.InDelta(t, 1890.0, ingredientsWeight(result.Ingredients), 0.05)
+	assert.InDelta(t, result.Ingredients.Flour, result.PrefermentBill.Preferment.Flour+result.PrefermentBill.FinalDough.Flour, 1e-9)
+	assert.Equal(t, result.Ingredients.Flour, result.Flours[0].Weight)
+}
+
+func TestTotalDoughWeightByPansBallsTooLight(t *testing.T) {
+	calculator := NewCalculatorService()
+	pan := bdomain.Pan{Shape: "round", Measures: bdomain.Measures{Diameter: floatPtr(20)}, BallCount: 100}
+
+	_, err := calculator.TotalDoughWeightByPans(context.Background(), bdomain.Pans{
+		ScalePrecision: 100,
+		Pans:           []bdomain.Pan{pan},
+	})
+	assert.ErrorIs(t, err, bdomain.ErrInvalidBallCount)
+
+	result, err := calculator.TotalDoughWeightByPans(context.Background(), bdomain.Pans{
+		ScalePrecision: 100,
+		Partial:        true,
+		Pans:           []bdomain.Pan{pan, {Shape: "square", Measures: bdomain.Measures{Edge: floatPtr(20)}}},
+	})
+	require.NoError(t, err)
+	require.Len(t, result.Errors, 1)
+	assert.Equal(t, 0, result.Errors[0].Index)
+	assert.Equal(t, 200.0, result.TotalBallWeight)
+}
+
+func ingredientsWeight(ingredients bdomain.Ingredients) float64 {
+	return ingredients.Flour + ingredients.Water + ingredients.Salt + ingredients.Yeast + ingredients.Oil + ingredients.Sugar
+}
+
+func TestTotalDoughWeightByPansLevainYeast(t *testing.T) {
+	calculator := NewCalculatorService()
+	pans := []bdomain.Pan{{Shape: "rectangular", Measures: bdomain.Measures{Width: floatPtr(30), Length: floatPtr(40)}}}
+	levain := &bdomain.Preferment{Type: bdomain.Levain, Inoculation: 20}
+
+	result, err := calculator.TotalDoughWeightByPans(context.Background(), bdomain.Pans{Pans: pans, Preferment: levain})
+	require.NoError(t, err)
+	assert.Zero(t, result.Formula.Yeast)
+	assert.Zero(t, result.Ingredients.Yeast)
+	require.NotNil(t, result.PrefermentBill)
+	assert.Zero(t, result.PrefermentBill.FinalDough.Yeast)
+
+	yeast := 0.2
+	result, err = calculator.TotalDoughWeightByPans(context.Background(), bdomain.Pans{
+		Pans:             pans,
+		Preferment:       levain,
+		FormulaOverrides: bdomain.FormulaOverrides{Yeast: &yeast},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 0.2, result.Formula.Yeast)
+	assert.Equal(t, result.Ingredients.Yeast, result.PrefermentBill.FinalDough.Yeast)
+
+	result, err = calculator.TotalDoughWeightByPans(context.Background(), bdomain.Pans{
+		Pans:         pans,
+		Preferment:   levain,
+		Fermentation: &bdomain.Fermentation{Hours: 8, Temperature: 24},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 0.5, result.Formula.Yeast)
+}
+
+type halfMoonStrategy struct{}
+
+func (s *halfMoonStrategy) Calculate(measures bdomain.Measures) (bdomain.Pan, error) {
+	radius := *measures.Diameter / 2
+	return bdomain.Pan{Shape: "half_moon", Measures: measures, Area: math.Pi * radius * radius / 2}, nil
+}
+
+func TestTotalDoughWeightByPansCustomRegistry(t *testing.T) {
+	registry := shapes.NewRegistry()
+	registry.MustRegister(shapes.Shape{
+		Name:     "half_moon",
+		Aliases:  []string{"calzone"},
+		Measures: []shapes.MeasureSpec{{Name: "diameter", Kind: shapes.LengthMeasure, Required: true}},
+		Strategy: &halfMoonStrategy{},
+	})
+	calculator := NewCalculatorService(WithShapeRegistry(registry))
+
+	result, err := calculator.TotalDoughWeightByPans(context.Background(), bdomain.Pans{
+		Pans: []bdomain.Pan{{Shape: "calzone", Measures: bdomain.Measures{Diameter: floatPtr(20)}}},
+	})
+	assert.NoError(t, err)
+	assert.InDelta(t, math.Pi*50, result.TotalArea, 0.001)
+
+	_, err = calculator.TotalDoughWeightByPans(context.Background(), bdomain.Pans{
+		Pans: []bdomain.Pan{{Shape: "round", Measures: bdomain.Measures{Diameter: floatPtr(20)}}},
+	})
+	assert.ErrorIs(t, err, bdomain.ErrUnsupportedShape)
+}
+
+func TestTotalDoughWeightByPansCatalog(t *testing.T) {
+	panCatalog := catalog.NewInMemoryPanCatalog()
+	require.NoError(t, panCatalog.Create(bdomain.CatalogPan{
+		ID:       "detroit-10x14",
+		Shape:    "rectangular",
+		Measures: bdomain.Measures{Width: floatPtr(10), Length: floatPtr(14), Unit: bdomain.Inches},
+	}))
+	require.NoError(t, panCatalog.Create(bdomain.CatalogPan{
+		ID:       "napoli-33",
+		Shape:    "round",
+		Measures: bdomain.Measures{Diameter: floatPtr(33)},
+	}))
+
+	calculator := NewCalculatorService(WithPanCatalog(panCatalog))
+
+	t.Run("resolves presets and converts to the request unit", func(t *testing.T) {
+		result, err := calculator.TotalDoughWeightByPans(context.Background(), bdomain.Pans{
+			Pans: []bdomain.Pan{
+				{CatalogID: "detroit-10x14", Quantity: 2},
+				{CatalogID: "napoli-33"},
+			},
+		})
+		require.NoError(t, err)
+
+		detroit := result.Pans[0]
+		assert.Equal(t, "detroit-10x14", detroit.CatalogID)
+		assert.Equal(t, "rectangular 10 x 14 in", detroit.Name)
+		assert.Equal(t, 903.22, detroit.Area)
+		assert.Equal(t, 451.61, detroit.DoughWeight)
+		assert.Equal(t, "round 33 cm", result.Pans[1].Name)
+		assert.InDelta(t, 2*903.22+855.3, result.TotalArea, 0.001)
+	})
+
+	t.Run("unknown preset", func(t *testing.T) {
+		_, err := calculator.TotalDoughWeightByPans(context.Background(), bdomain.Pans{
+			Pans: []bdomain.Pan{{CatalogID: "missing"}},
+		})
+		assert.ErrorIs(t, err, bdomain.ErrCatalogPanNotFound)
+	})
+
+	t.Run("no catalog configured", func(t *testing.T) {
+		_, err := NewCalculatorService().TotalDoughWeightByPans(context.Background(), bdomain.Pans{
+			Pans: []bdomain.Pan{{CatalogID: "napoli-33"}},
+		})
+		assert.ErrorIs(t, err, bdomain.ErrCatalogNotConfigured)
+	})
+}
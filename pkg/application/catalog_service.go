@@ -0,0 +1,123 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/cfioretti/calculator/pkg/domain"
+	"github.com/cfioretti/calculator/pkg/shapes"
+)
+
+var catalogIDPattern = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+type PanCatalogService struct {
+	catalog  domain.PanCatalog
+	registry *shapes.Registry
+}
+
+func NewPanCatalogService(catalog domain.PanCatalog, registry *shapes.Registry) *PanCatalogService {
+	return &PanCatalogService{
+		catalog:  catalog,
+		registry: registry,
+	}
+}
+
+func (cs PanCatalogService) CreatePan(ctx context.Context, pan domain.CatalogPan) (*domain.CatalogPan, error) {
+	if err := cs.validate(&pan); err != nil {
+		return nil, err
+	}
+	if err := cs.catalog.Create(pan); err != nil {
+		return nil, err
+	}
+	return &pan, nil
+}
+
+func (cs PanCatalogService) GetPan(ctx context.Context, id string) (*domain.CatalogPan, error) {
+	pan, err := cs.catalog.Get(id)
+	if err != nil {
+		return nil, err
+	}
+	return &pan, nil
+}
+
+func (cs PanCatalogService) ListPans(ctx context.Context) []domain.CatalogPan {
+	return cs.catalog.List()
+}
+
+func (cs PanCatalogService) UpdatePan(ctx context.Context, pan domain.CatalogPan) (*domain.CatalogPan, error) {
+	if err := cs.validate(&pan); err != nil {
+		return nil, err
+	}
+	if err := cs.catalog.Update(pan); err != nil {
+		return nil, err
+	}
+	return &pan, nil
+}
+
+func (cs PanCatalogService) DeletePan(ctx context.Context, id string) error {
+	return cs.catalog.Delete(id)
+}
+
+// validate rejects presets that could never be calculated, so a bad entry
+// fails when it is saved rather than on every request that references it.
+// It also normalises the shape name and the unit of the preset.
+func (cs PanCatalogService) validate(pan *domain.CatalogPan) error {
+	if !catalogIDPattern.MatchString(pan.ID) {
+		return fmt.Errorf("%w: id %q must be lowercase words separated by dashes", domain.ErrInvalidCatalogPan, pan.ID)
+	}
+
+	unit, err := domain.ParseUnit(string(pan.Measures.Unit))
+	if err != nil {
+		return fmt.Errorf("%w: %v", domain.ErrInvalidCatalogPan, err)
+	}
+	pan.Measures.Unit = unit
+
+	shape, err := cs.registry.Lookup(pan.Shape)
+	if err != nil {
+		return fmt.Errorf("%w: %v", domain.ErrInvalidCatalogPan, err)
+	}
+	pan.Shape = shape.Name
+
+	if err := measureRanges(shape, pan.Measures); err != nil {
+		return fmt.Errorf("%w: %v", domain.ErrInvalidCatalogPan, err)
+	}
+	if _, err := shape.Strategy.Calculate(pan.Measures); err != nil {
+		return fmt.Errorf("%w: %v", domain.ErrInvalidCatalogPan, err)
+	}
+	return nil
+}
+
+// measureRanges checks every measure the shape reads against the range the
+// shape registry declares for it, in the unit of the measures.
+func measureRanges(shape shapes.Shape, measures domain.Measures) error {
+	unit := measures.Unit.OrDefault()
+	values := measures.Values()
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		spec, ok := shape.Measure(name)
+		if !ok {
+			continue
+		}
+		spec = spec.In(unit)
+		if value := values[name]; value < spec.Min || value > spec.Max {
+			return fmt.Errorf("%s must be between %g and %g, got %g", name, spec.Min, spec.Max, value)
+		}
+	}
+
+	if spec, ok := shape.Measure("vertices"); ok {
+		spec = spec.In(unit)
+		for i, vertex := range measures.Vertices {
+			if vertex.X < spec.Min || vertex.X > spec.Max || vertex.Y < spec.Min || vertex.Y > spec.Max {
+				return fmt.Errorf("vertex %d must be between %g and %g", i, spec.Min, spec.Max)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cfioretti/calculator/internal/infrastructure/catalog"
+	bdomain "github.com/cfioretti/calculator/pkg/domain"
+	"github.com/cfioretti/calculator/pkg/shapes"
+)
+
+func TestPanCatalogServiceCreatePan(t *testing.T) {
+	tests := []struct {
+		name      string
+		pan       bdomain.CatalogPan
+		wantShape string
+		wantUnit  bdomain.Unit
+		wantErr   bool
+	}{
+		{
+			name:      "valid preset with alias and unit normalised",
+			pan:       bdomain.CatalogPan{ID: "detroit-10x14", Shape: "Rectangle", Measures: bdomain.Measures{Width: floatPtr(10), Length: floatPtr(14), Unit: "inches"}},
+			wantShape: "rectangular",
+			wantUnit:  bdomain.Inches,
+		},
+		{
+			name:    "invalid id",
+			pan:     bdomain.CatalogPan{ID: "Napoli 33", Shape: "round", Measures: bdomain.Measures{Diameter: floatPtr(33)}},
+			wantErr: true,
+		},
+		{
+			name:    "unknown shape",
+			pan:     bdomain.CatalogPan{ID: "star-30", Shape: "star", Measures: bdomain.Measures{Diameter: floatPtr(30)}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid measures",
+			pan:     bdomain.CatalogPan{ID: "napoli-33", Shape: "round"},
+			wantErr: true,
+		},
+		{
+			name:    "negative edge",
+			pan:     bdomain.CatalogPan{ID: "square-30", Shape: "square", Measures: bdomain.Measures{Edge: floatPtr(-30)}},
+			wantErr: true,
+		},
+		{
+			name:    "diameter out of range",
+			pan:     bdomain.CatalogPan{ID: "napoli-huge", Shape: "round", Measures: bdomain.Measures{Diameter: floatPtr(100000)}},
+			wantErr: true,
+		},
+		{
+			name:    "range checked in the preset unit",
+			pan:     bdomain.CatalogPan{ID: "detroit-huge", Shape: "rectangular", Measures: bdomain.Measures{Width: floatPtr(10), Length: floatPtr(200), Unit: "in"}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid unit",
+			pan:     bdomain.CatalogPan{ID: "napoli-33", Shape: "round", Measures: bdomain.Measures{Diameter: floatPtr(33), Unit: "ft"}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := NewPanCatalogService(catalog.NewInMemoryPanCatalog(), shapes.Default())
+
+			created, err := service.CreatePan(context.Background(), tt.pan)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, bdomain.ErrInvalidCatalogPan)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantShape, created.Shape)
+			assert.Equal(t, tt.wantUnit, created.Measures.Unit)
+
+			stored, err := service.GetPan(context.Background(), tt.pan.ID)
+			require.NoError(t, err)
+			assert.Equal(t, created, stored)
+		})
+	}
+}
+
+func TestPanCatalogServiceUpdateAndDelete(t *testing.T) {
+	ctx := context.Background()
+	service := NewPanCatalogService(catalog.NewInMemoryPanCatalog(), shapes.Default())
+
+	_, err := service.UpdatePan(ctx, bdomain.CatalogPan{ID: "napoli-33", Shape: "round", Measures: bdomain.Measures{Diameter: floatPtr(33)}})
+	assert.ErrorIs(t, err, bdomain.ErrCatalogPanNotFound)
+
+	_, err = service.CreatePan(ctx, bdomain.CatalogPan{ID: "napoli-33", Shape: "round", Measures: bdomain.Measures{Diameter: floatPtr(33)}})
+	require.NoError(t, err)
+
+	updated, err := service.UpdatePan(ctx, bdomain.CatalogPan{ID: "napoli-33", Shape: "round", Measures: bdomain.Measures{Diameter: floatPtr(32)}})
+	require.NoError(t, err)
+	assert.Equal(t, 32.0, *updated.Measures.Diameter)
+	assert.Len(t, service.ListPans(ctx), 1)
+
+	require.NoError(t, service.DeletePan(ctx, "napoli-33"))
+	assert.Empty(t, service.ListPans(ctx))
+}
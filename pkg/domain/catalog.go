@@ -0,0 +1,26 @@
+package domain
+
+import "errors"
+
+var (
+	ErrCatalogPanNotFound = errors.New("catalog pan not found")
+	ErrCatalogPanExists   = errors.New("catalog pan already exists")
+	ErrInvalidCatalogPan  = errors.New("invalid catalog pan")
+)
+
+// CatalogPan is a named pan preset that requests can reference by ID
+// instead of sending raw measures.
+type CatalogPan struct {
+	ID          string   `json:"id"`
+	Shape       string   `json:"shape"`
+	Measures    Measures `json:"measures"`
+	Description string   `json:"description,omitempty"`
+}
+
+type PanCatalog interface {
+	Get(id string) (CatalogPan, error)
+	List() []CatalogPan
+	Create(pan CatalogPan) error
+	Update(pan CatalogPan) error
+	Delete(id string) error
+}
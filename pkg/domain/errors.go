@@ -0,0 +1,36 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Errors caused by the content of a request. Anything else returned while
+// calculating pans is a server fault.
+var (
+	ErrUnsupportedShape     = errors.New("unsupported shape")
+	ErrInvalidMeasures      = errors.New("invalid measures")
+	ErrInvalidQuantity      = errors.New("invalid quantity")
+	ErrInvalidBallCount     = errors.New("invalid ball count")
+	ErrCatalogNotConfigured = errors.New("pan catalog is not configured")
+	ErrUnknownStyle         = errors.New("unknown style")
+	ErrInvalidFormula       = errors.New("invalid formula")
+	ErrInvalidFermentation  = errors.New("invalid fermentation")
+	ErrInvalidPreferment    = errors.New("invalid preferment")
+	ErrUnknownFlour         = errors.New("unknown flour")
+	ErrInvalidBlend         = errors.New("invalid flour blend")
+)
+
+// PanError reports which pan of a request could not be calculated.
+type PanError struct {
+	Index int
+	Err   error
+}
+
+func (e *PanError) Error() string {
+	return fmt.Sprintf("pan %d: %v", e.Index, e.Err)
+}
+
+func (e *PanError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,28 @@
+package domain
+
+// Flour is an entry of the flour table. Absorption is the water the flour
+// takes beyond the reference tipo 00, in points of hydration: a blend adds
+// the share-weighted absorption of its flours to the formula hydration.
+type Flour struct {
+	ID         string  `json:"id"`
+	Name       string  `json:"name"`
+	Absorption float64 `json:"absorption"`
+}
+
+type FlourTable interface {
+	Get(id string) (Flour, error)
+	List() []Flour
+}
+
+// BlendPart is a flour of a blend and its percentage of the total flour.
+type BlendPart struct {
+	FlourID string
+	Share   float64
+}
+
+// BlendFlour is a flour of a resolved blend and its weight in grams.
+type BlendFlour struct {
+	Flour  Flour
+	Share  float64
+	Weight float64
+}
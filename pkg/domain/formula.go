@@ -0,0 +1,136 @@
+package domain
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// Formula is a dough formula in baker's percentages: flour is 100 and every
+// other ingredient is a percentage of the flour weight.
+type Formula struct {
+	Hydration float64
+	Salt      float64
+	Yeast     float64
+	YeastType YeastType
+	Oil       float64
+	Sugar     float64
+}
+
+// DefaultFormula applies when neither the request nor its style sets a
+// percentage.
+var DefaultFormula = Formula{Hydration: 65, Salt: 2.5, Yeast: 0.5, YeastType: FreshYeast}
+
+// FormulaOverrides holds the percentages a request sets explicitly; the
+// others come from the style or the default formula.
+type FormulaOverrides struct {
+	Hydration *float64
+	Salt      *float64
+	Yeast     *float64
+	Oil       *float64
+	Sugar     *float64
+}
+
+// Ingredients are ingredient weights in grams.
+type Ingredients struct {
+	Flour float64
+	Water float64
+	Salt  float64
+	Yeast float64
+	Oil   float64
+	Sugar float64
+}
+
+// Apply returns the formula with the overridden percentages replaced.
+func (o FormulaOverrides) Apply(formula Formula) Formula {
+	override := func(value *float64, fallback float64) float64 {
+		if value == nil {
+			return fallback
+		}
+		return *value
+	}
+	return Formula{
+		Hydration: override(o.Hydration, formula.Hydration),
+		Salt:      override(o.Salt, formula.Salt),
+		Yeast:     override(o.Yeast, formula.Yeast),
+		YeastType: formula.YeastType,
+		Oil:       override(o.Oil, formula.Oil),
+		Sugar:     override(o.Sugar, formula.Sugar),
+	}
+}
+
+func (f Formula) Validate() error {
+	for _, percentage := range []float64{f.Hydration, f.Salt, f.Yeast, f.Oil, f.Sugar} {
+		if math.IsNaN(percentage) || math.IsInf(percentage, 0) {
+			return fmt.Errorf("%w: percentages must be finite numbers", ErrInvalidFormula)
+		}
+	}
+	if f.Hydration <= 0 {
+		return fmt.Errorf("%w: hydration must be positive", ErrInvalidFormula)
+	}
+	if f.Salt < 0 || f.Yeast < 0 || f.Oil < 0 || f.Sugar < 0 {
+		return fmt.Errorf("%w: percentages cannot be negative", ErrInvalidFormula)
+	}
+	return nil
+}
+
+// TotalPercentage is the sum of all the percentages, flour included.
+func (f Formula) TotalPercentage() float64 {
+	return 100 + f.Hydration + f.Salt + f.Yeast + f.Oil + f.Sugar
+}
+
+// Ingredients splits a dough weight into the weight of each ingredient,
+// rounded to hundredths of a gram.
+func (f Formula) Ingredients(doughWeight float64) Ingredients {
+	flour := doughWeight * 100 / f.TotalPercentage()
+	part := func(percentage float64) float64 {
+		return math.Round(flour*percentage) / 100
+	}
+	return Ingredients{
+		Flour: part(100),
+		Water: part(f.Hydration),
+		Salt:  part(f.Salt),
+		Yeast: part(f.Yeast),
+		Oil:   part(f.Oil),
+		Sugar: part(f.Sugar),
+	}
+}
+
+// YeastType is the form of the yeast in a formula.
+type YeastType string
+
+const (
+	FreshYeast     YeastType = "fresh"
+	ActiveDryYeast YeastType = "active_dry"
+	InstantYeast   YeastType = "instant"
+)
+
+// ParseYeastType reads a yeast type, defaulting to fresh yeast when empty.
+func ParseYeastType(value string) (YeastType, error) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "", "fresh", "cake", "compressed":
+		return FreshYeast, nil
+	case "active_dry", "active dry", "dry":
+		return ActiveDryYeast, nil
+	case "instant", "instant_dry", "instant dry":
+		return InstantYeast, nil
+	default:
+		return "", fmt.Errorf("unsupported yeast type: %s", value)
+	}
+}
+
+// OrDefault returns the yeast type, falling back to fresh yeast when unset.
+func (y YeastType) OrDefault() YeastType {
+	if y == "" {
+		return FreshYeast
+	}
+	return y
+}
+
+// Fermentation describes how long and how warm a dough ferments. The
+// temperature is in degrees Celsius.
+type Fermentation struct {
+	Hours       float64
+	Temperature float64
+	YeastType   YeastType
+}
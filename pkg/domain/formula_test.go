@@ -0,0 +1,81 @@
+package domain
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormulaIngredients(t *testing.T) {
+	tests := []struct {
+		name        string
+		formula     Formula
+		doughWeight float64
+		want        Ingredients
+	}{
+		{
+			name:        "every ingredient",
+			formula:     Formula{Hydration: 70, Salt: 3, Yeast: 1, Oil: 3, Sugar: 3},
+			doughWeight: 1800,
+			want:        Ingredients{Flour: 1000, Water: 700, Salt: 30, Yeast: 10, Oil: 30, Sugar: 30},
+		},
+		{
+			name:        "default formula",
+			formula:     DefaultFormula,
+			doughWeight: 200,
+			want:        Ingredients{Flour: 119.05, Water: 77.38, Salt: 2.98, Yeast: 0.6},
+		},
+		{
+			name:        "no dough",
+			formula:     DefaultFormula,
+			doughWeight: 0,
+			want:        Ingredients{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.formula.Ingredients(tt.doughWeight))
+		})
+	}
+}
+
+func TestFormulaOverrides(t *testing.T) {
+	hydration, oil := 75.0, 0.0
+	style := Style{Hydration: 70, Salt: 2, Oil: 3}
+
+	formula := FormulaOverrides{Hydration: &hydration, Oil: &oil}.Apply(style.Formula())
+	assert.Equal(t, Formula{Hydration: 75, Salt: 2, Yeast: DefaultFormula.Yeast, YeastType: FreshYeast}, formula)
+	assert.NoError(t, formula.Validate())
+
+	negative := -1.0
+	assert.ErrorIs(t, FormulaOverrides{Salt: &negative}.Apply(formula).Validate(), ErrInvalidFormula)
+	assert.ErrorIs(t, Formula{}.Validate(), ErrInvalidFormula)
+
+	nan, infinity := math.NaN(), math.Inf(1)
+	assert.ErrorIs(t, FormulaOverrides{Hydration: &nan}.Apply(formula).Validate(), ErrInvalidFormula)
+	assert.ErrorIs(t, FormulaOverrides{Oil: &infinity}.Apply(formula).Validate(), ErrInvalidFormula)
+}
+
+func TestParseYeastType(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected YeastType
+	}{
+		{"", FreshYeast},
+		{"Fresh", FreshYeast},
+		{"active dry", ActiveDryYeast},
+		{"instant", InstantYeast},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			yeastType, err := ParseYeastType(tt.input)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, yeastType)
+		})
+	}
+
+	_, err := ParseYeastType("sourdough")
+	assert.Error(t, err)
+}
@@ -0,0 +1,5 @@
+package domain
+
+type PanStrategy interface {
+	Calculate(measures Measures) (Pan, error)
+}
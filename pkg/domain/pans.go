@@ -1,20 +1,165 @@
 package domain
 
+// DefaultThicknessFactor is the grams of dough per square centimetre of pan
+// used when a request does not provide its own thickness factor.
+const DefaultThicknessFactor = 0.5
+
+// DefaultRiseFactor is how many times the dough grows while proofing when a
+// request does not provide its own rise factor.
+const DefaultRiseFactor = 2.0
+
+// DefaultWasteFactor scales dough balls up for the dough lost in the bowl
+// and on the bench when a request does not provide its own factor.
+const DefaultWasteFactor = 1.0
+
+// DefaultScalePrecision is the resolution, in grams, ball weights are
+// rounded to when a request does not provide its own.
+const DefaultScalePrecision = 1.0
+
+// DoughDensity is the density of unproofed dough in grams per cubic centimetre.
+const DoughDensity = 1.1
+
 type Pans struct {
-	Pans      []Pan
-	TotalArea float64
+	Pans             []Pan
+	TotalArea        float64
+	ThicknessFactor  float64
+	TotalDoughWeight float64
+	RiseFactor       float64
+	Unit             Unit
+	// Partial keeps pans that cannot be calculated in the result, in their
+	// original position, and reports them in Errors instead of failing.
+	Partial bool
+	Errors  []PanError
+	// Strict rejects measures a shape does not read or that conflict with
+	// each other, instead of ignoring them.
+	Strict bool
+	// StyleID selects the style profile whose defaults apply to the request;
+	// Style is the profile that was applied.
+	StyleID string
+	Style   *Style
+	// FormulaOverrides are the request percentages; Formula is the formula
+	// applied and Ingredients the bill for MixDoughWeight.
+	FormulaOverrides FormulaOverrides
+	Formula          Formula
+	Ingredients      Ingredients
+	// Fermentation, when set, sizes the yeast unless the request overrides
+	// the yeast percentage.
+	Fermentation *Fermentation
+	// Preferment, when set, splits Ingredients into PrefermentBill.
+	Preferment     *Preferment
+	PrefermentBill *PrefermentBill
+	// Blend declares the flours of the dough; Flours splits the flour of
+	// Ingredients between them and HydrationAdjustment is the points the
+	// blend added to the formula hydration.
+	Blend               []BlendPart
+	Flours              []BlendFlour
+	HydrationAdjustment float64
+	// WasteFactor and ScalePrecision size the dough balls of each pan;
+	// TotalBallWeight is the dough all the balls take. MixDoughWeight is the
+	// dough to mix, which Ingredients, PrefermentBill and Flours are based
+	// on: TotalDoughWeight scaled by WasteFactor, and at least
+	// TotalBallWeight.
+	WasteFactor     float64
+	ScalePrecision  float64
+	TotalBallWeight float64
+	MixDoughWeight  float64
 }
 
 type Pan struct {
-	Shape    string
-	Measures Measures
-	Name     string
-	Area     float64
+	CatalogID string
+	Shape     string
+	Measures  Measures
+	// DoughOnWalls runs the dough up the side walls of a pan with a depth,
+	// as in a deep dish; otherwise the depth only sizes the pan volume.
+	DoughOnWalls bool
+	Name         string
+	// Area is the surface inside the measures; DoughArea excludes the pan
+	// walls and ToppingArea also excludes the crust border.
+	Area        float64
+	DoughArea   float64
+	ToppingArea float64
+	SideArea    float64
+	Volume      float64
+	DoughWeight float64
+	Warnings    []string
+	// Quantity is the number of identical pans on this line; Area and
+	// DoughWeight are per unit, the Line values cover the whole line.
+	Quantity        int
+	LineArea        float64
+	LineDoughWeight float64
+	// Ingredients is the bill for a single pan of the line, including the
+	// dough lost to the waste factor.
+	Ingredients Ingredients
+	// BallCount is the number of dough balls per pan, 1 unless set, and
+	// BallWeight the weight of each.
+	BallCount  int
+	BallWeight float64
 }
 
 type Measures struct {
-	Diameter *int
-	Edge     *int
-	Width    *int
-	Length   *int
+	Diameter      *float64 `json:"diameter,omitempty"`
+	Edge          *float64 `json:"edge,omitempty"`
+	Width         *float64 `json:"width,omitempty"`
+	Length        *float64 `json:"length,omitempty"`
+	MajorAxis     *float64 `json:"majorAxis,omitempty"`
+	MinorAxis     *float64 `json:"minorAxis,omitempty"`
+	Depth         *float64 `json:"depth,omitempty"`
+	TopDiameter   *float64 `json:"topDiameter,omitempty"`
+	TopEdge       *float64 `json:"topEdge,omitempty"`
+	TopWidth      *float64 `json:"topWidth,omitempty"`
+	TopLength     *float64 `json:"topLength,omitempty"`
+	CornerRadius  *float64 `json:"cornerRadius,omitempty"`
+	OuterDiameter *float64 `json:"outerDiameter,omitempty"`
+	InnerDiameter *float64 `json:"innerDiameter,omitempty"`
+	Vertices      []Point  `json:"vertices,omitempty"`
+	Sides         *int     `json:"sides,omitempty"`
+	Circumradius  *float64 `json:"circumradius,omitempty"`
+	SideA         *float64 `json:"sideA,omitempty"`
+	SideB         *float64 `json:"sideB,omitempty"`
+	SideC         *float64 `json:"sideC,omitempty"`
+	WallThickness *float64 `json:"wallThickness,omitempty"`
+	CrustBorder   *float64 `json:"crustBorder,omitempty"`
+	Unit          Unit     `json:"unit,omitempty"`
+}
+
+type Point struct {
+	X float64 `json:"x"`
+	Y float64 `json:"y"`
+}
+
+// Values returns the scalar measures that are set, keyed by the same names
+// used in JSON and in the shape registry. Vertices are not included.
+func (m Measures) Values() map[string]float64 {
+	values := make(map[string]float64)
+	scalars := map[string]*float64{
+		"diameter":      m.Diameter,
+		"edge":          m.Edge,
+		"width":         m.Width,
+		"length":        m.Length,
+		"majorAxis":     m.MajorAxis,
+		"minorAxis":     m.MinorAxis,
+		"depth":         m.Depth,
+		"topDiameter":   m.TopDiameter,
+		"topEdge":       m.TopEdge,
+		"topWidth":      m.TopWidth,
+		"topLength":     m.TopLength,
+		"cornerRadius":  m.CornerRadius,
+		"outerDiameter": m.OuterDiameter,
+		"innerDiameter": m.InnerDiameter,
+		"circumradius":  m.Circumradius,
+		"sideA":         m.SideA,
+		"sideB":         m.SideB,
+		"sideC":         m.SideC,
+		"wallThickness": m.WallThickness,
+		"crustBorder":   m.CrustBorder,
+	}
+	for name, value := range scalars {
+		if value != nil {
+			values[name] = *value
+		}
+	}
+	if m.Sides != nil {
+		values["sides"] = float64(*m.Sides)
+	}
+	return values
 }
@@ -0,0 +1,49 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+// PrefermentType is a dough mixed and fermented ahead of the final dough.
+type PrefermentType string
+
+const (
+	Poolish PrefermentType = "poolish"
+	Biga    PrefermentType = "biga"
+	Levain  PrefermentType = "levain"
+)
+
+// ParsePrefermentType reads a preferment type.
+func ParsePrefermentType(value string) (PrefermentType, error) {
+	switch PrefermentType(strings.ToLower(strings.TrimSpace(value))) {
+	case Poolish:
+		return Poolish, nil
+	case Biga:
+		return Biga, nil
+	case Levain, "sourdough", "starter":
+		return Levain, nil
+	default:
+		return "", fmt.Errorf("unsupported preferment type: %s", value)
+	}
+}
+
+// Preferment sets how much of the formula is fermented ahead. FlourShare is
+// the percentage of the total flour that goes into the preferment and
+// Hydration its water as a percentage of that flour; a zero Hydration uses
+// the default of the type. Inoculation is the weight of the preferment as a
+// percentage of the total flour, the usual way to size a levain; its flour
+// and water count toward the formula like those of any other preferment.
+type Preferment struct {
+	Type        PrefermentType
+	FlourShare  float64
+	Hydration   float64
+	Inoculation float64
+}
+
+// PrefermentBill splits the ingredients of a dough between the preferment
+// and the final dough; each ingredient of the two sums to the whole bill.
+type PrefermentBill struct {
+	Preferment Ingredients
+	FinalDough Ingredients
+}
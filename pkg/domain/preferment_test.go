@@ -0,0 +1,29 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePrefermentType(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected PrefermentType
+	}{
+		{"poolish", Poolish},
+		{" Biga ", Biga},
+		{"levain", Levain},
+		{"sourdough", Levain},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			prefermentType, err := ParsePrefermentType(tt.input)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, prefermentType)
+		})
+	}
+
+	_, err := ParsePrefermentType("")
+	assert.Error(t, err)
+}
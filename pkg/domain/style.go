@@ -0,0 +1,29 @@
+package domain
+
+// Style is a pizza style profile. Hydration, Salt, Oil and Sugar are baker's
+// percentages, relative to the weight of the flour.
+type Style struct {
+	ID              string
+	Name            string
+	Description     string
+	ThicknessFactor float64
+	Hydration       float64
+	Salt            float64
+	Oil             float64
+	Sugar           float64
+}
+
+type StyleCatalog interface {
+	Get(id string) (Style, error)
+	List() []Style
+}
+
+// Formula returns the style percentages on top of the default formula.
+func (s Style) Formula() Formula {
+	formula := DefaultFormula
+	formula.Hydration = s.Hydration
+	formula.Salt = s.Salt
+	formula.Oil = s.Oil
+	formula.Sugar = s.Sugar
+	return formula
+}
@@ -0,0 +1,64 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+type Unit string
+
+const (
+	Centimeters Unit = "cm"
+	Inches      Unit = "in"
+)
+
+const centimetersPerInch = 2.54
+
+func ParseUnit(value string) (Unit, error) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "", "cm", "centimeter", "centimeters":
+		return Centimeters, nil
+	case "in", "inch", "inches":
+		return Inches, nil
+	default:
+		return "", fmt.Errorf("unsupported unit: %s", value)
+	}
+}
+
+// OrDefault returns the unit, falling back to centimetres when unset.
+func (u Unit) OrDefault() Unit {
+	if u == "" {
+		return Centimeters
+	}
+	return u
+}
+
+func (u Unit) ToCentimeters(value float64) float64 {
+	if u.OrDefault() == Inches {
+		return value * centimetersPerInch
+	}
+	return value
+}
+
+func (u Unit) FromCentimeters(value float64) float64 {
+	if u.OrDefault() == Inches {
+		return value / centimetersPerInch
+	}
+	return value
+}
+
+func (u Unit) ToSquareCentimeters(area float64) float64 {
+	return u.ToCentimeters(u.ToCentimeters(area))
+}
+
+func (u Unit) FromSquareCentimeters(area float64) float64 {
+	return u.FromCentimeters(u.FromCentimeters(area))
+}
+
+func (u Unit) ToCubicCentimeters(volume float64) float64 {
+	return u.ToCentimeters(u.ToSquareCentimeters(volume))
+}
+
+func (u Unit) FromCubicCentimeters(volume float64) float64 {
+	return u.FromCentimeters(u.FromSquareCentimeters(volume))
+}
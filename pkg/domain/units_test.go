@@ -0,0 +1,42 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseUnit(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    Unit
+		wantErr bool
+	}{
+		{"empty defaults to centimeters", "", Centimeters, false},
+		{"centimeters", "cm", Centimeters, false},
+		{"inches", "in", Inches, false},
+		{"inches long form", "Inches", Inches, false},
+		{"unsupported unit", "ft", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			unit, err := ParseUnit(tt.value)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, unit)
+		})
+	}
+}
+
+func TestUnitConversions(t *testing.T) {
+	assert.InDelta(t, 25.4, Inches.ToCentimeters(10), 0.0001)
+	assert.InDelta(t, 10, Inches.FromCentimeters(25.4), 0.0001)
+	assert.InDelta(t, 6.4516, Inches.ToSquareCentimeters(1), 0.0001)
+	assert.InDelta(t, 1, Inches.FromSquareCentimeters(6.4516), 0.0001)
+	assert.Equal(t, 30.0, Unit("").ToCentimeters(30))
+}
@@ -0,0 +1,122 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cfioretti/calculator/pkg/domain"
+	pb "github.com/cfioretti/calculator/pkg/infrastructure/grpc/proto/generated"
+)
+
+type CatalogService interface {
+	CreatePan(context.Context, domain.CatalogPan) (*domain.CatalogPan, error)
+	GetPan(context.Context, string) (*domain.CatalogPan, error)
+	ListPans(context.Context) []domain.CatalogPan
+	UpdatePan(context.Context, domain.CatalogPan) (*domain.CatalogPan, error)
+	DeletePan(context.Context, string) error
+}
+
+func (s *Server) CreateCatalogPan(ctx context.Context, req *pb.CatalogPanRequest) (*pb.CatalogPanResponse, error) {
+	if s.catalogService == nil {
+		return nil, errCatalogNotConfigured
+	}
+
+	pan, err := toDomainCatalogPan(req.Pan)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	created, err := s.catalogService.CreatePan(ctx, pan)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.CatalogPanResponse{Pan: toProtoCatalogPan(*created)}, nil
+}
+
+func (s *Server) GetCatalogPan(ctx context.Context, req *pb.CatalogPanIdRequest) (*pb.CatalogPanResponse, error) {
+	if s.catalogService == nil {
+		return nil, errCatalogNotConfigured
+	}
+
+	pan, err := s.catalogService.GetPan(ctx, req.Id)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.CatalogPanResponse{Pan: toProtoCatalogPan(*pan)}, nil
+}
+
+func (s *Server) ListCatalogPans(ctx context.Context, req *pb.ListCatalogPansRequest) (*pb.ListCatalogPansResponse, error) {
+	if s.catalogService == nil {
+		return nil, errCatalogNotConfigured
+	}
+
+	pans := s.catalogService.ListPans(ctx)
+	panProtos := make([]*pb.CatalogPanProto, 0, len(pans))
+	for _, pan := range pans {
+		panProtos = append(panProtos, toProtoCatalogPan(pan))
+	}
+	return &pb.ListCatalogPansResponse{Pans: panProtos}, nil
+}
+
+func (s *Server) UpdateCatalogPan(ctx context.Context, req *pb.CatalogPanRequest) (*pb.CatalogPanResponse, error) {
+	if s.catalogService == nil {
+		return nil, errCatalogNotConfigured
+	}
+
+	pan, err := toDomainCatalogPan(req.Pan)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	updated, err := s.catalogService.UpdatePan(ctx, pan)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.CatalogPanResponse{Pan: toProtoCatalogPan(*updated)}, nil
+}
+
+func (s *Server) DeleteCatalogPan(ctx context.Context, req *pb.CatalogPanIdRequest) (*pb.DeleteCatalogPanResponse, error) {
+	if s.catalogService == nil {
+		return nil, errCatalogNotConfigured
+	}
+
+	if err := s.catalogService.DeletePan(ctx, req.Id); err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.DeleteCatalogPanResponse{}, nil
+}
+
+var errCatalogNotConfigured = status.Error(codes.Unimplemented, "pan catalog is not configured")
+
+func toDomainCatalogPan(pan *pb.CatalogPanProto) (domain.CatalogPan, error) {
+	if pan == nil {
+		return domain.CatalogPan{}, errors.New("pan is required")
+	}
+	if pan.Measures == nil {
+		return domain.CatalogPan{}, errors.New("pan measures are required")
+	}
+
+	measures, err := toDomainMeasures(pan.Measures)
+	if err != nil {
+		return domain.CatalogPan{}, err
+	}
+
+	return domain.CatalogPan{
+		ID:          pan.Id,
+		Shape:       pan.Shape,
+		Measures:    measures,
+		Description: pan.Description,
+	}, nil
+}
+
+func toProtoCatalogPan(pan domain.CatalogPan) *pb.CatalogPanProto {
+	return &pb.CatalogPanProto{
+		Id:          pan.ID,
+		Shape:       pan.Shape,
+		Measures:    toProtoMeasures(pan.Measures),
+		Description: pan.Description,
+	}
+}
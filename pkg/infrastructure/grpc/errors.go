@@ -0,0 +1,103 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cfioretti/calculator/pkg/domain"
+	pb "github.com/cfioretti/calculator/pkg/infrastructure/grpc/proto/generated"
+)
+
+// toStatus translates a service error into a gRPC status. Domain errors
+// caused by the request map to client error codes, anything else is
+// reported as Internal.
+func toStatus(err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	st := status.New(statusCode(err), err.Error())
+
+	var panErr *domain.PanError
+	if st.Code() == codes.InvalidArgument && errors.As(err, &panErr) {
+		field := panPath(panErr.Index)
+		if name := panField(panErr.Err); name != "" {
+			field += "." + name
+		}
+		violation := &errdetails.BadRequest_FieldViolation{
+			Field:       field,
+			Description: panErr.Err.Error(),
+		}
+		if detailed, detailsErr := st.WithDetails(&errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequest_FieldViolation{violation},
+		}); detailsErr == nil {
+			st = detailed
+		}
+	}
+	return st.Err()
+}
+
+func statusCode(err error) codes.Code {
+	switch {
+	case errors.Is(err, context.Canceled):
+		return codes.Canceled
+	case errors.Is(err, context.DeadlineExceeded):
+		return codes.DeadlineExceeded
+	case errors.Is(err, domain.ErrCatalogPanNotFound):
+		return codes.NotFound
+	case errors.Is(err, domain.ErrCatalogPanExists):
+		return codes.AlreadyExists
+	case errors.Is(err, domain.ErrCatalogNotConfigured):
+		return codes.Unimplemented
+	case errors.Is(err, domain.ErrInvalidCatalogPan),
+		errors.Is(err, domain.ErrUnsupportedShape),
+		errors.Is(err, domain.ErrInvalidMeasures),
+		errors.Is(err, domain.ErrInvalidQuantity),
+		errors.Is(err, domain.ErrInvalidBallCount),
+		errors.Is(err, domain.ErrUnknownStyle),
+		errors.Is(err, domain.ErrInvalidFormula),
+		errors.Is(err, domain.ErrInvalidFermentation),
+		errors.Is(err, domain.ErrInvalidPreferment),
+		errors.Is(err, domain.ErrUnknownFlour),
+		errors.Is(err, domain.ErrInvalidBlend):
+		return codes.InvalidArgument
+	default:
+		return codes.Internal
+	}
+}
+
+// toProtoPanError describes why a pan could not be calculated in partial
+// mode.
+func toProtoPanError(err error) *pb.PanErrorProto {
+	panError := &pb.PanErrorProto{
+		Code:    statusCode(err).String(),
+		Message: err.Error(),
+	}
+	if field := panField(err); field != "" {
+		panError.Fields = []string{field}
+	}
+	return panError
+}
+
+// panField returns the path, relative to the pan, of the field an error is
+// about.
+func panField(err error) string {
+	switch {
+	case errors.Is(err, domain.ErrUnsupportedShape):
+		return "shape"
+	case errors.Is(err, domain.ErrInvalidMeasures):
+		return "measures"
+	case errors.Is(err, domain.ErrInvalidQuantity):
+		return "quantity"
+	case errors.Is(err, domain.ErrInvalidBallCount):
+		return "ballCount"
+	case errors.Is(err, domain.ErrCatalogPanNotFound), errors.Is(err, domain.ErrCatalogNotConfigured):
+		return "catalogId"
+	default:
+		return ""
+	}
+}
@@ -22,13 +22,38 @@ const (
 )
 
 type MeasuresProto struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Diameter      *int32                 `protobuf:"varint,1,opt,name=diameter,proto3,oneof" json:"diameter,omitempty"`
-	Edge          *int32                 `protobuf:"varint,2,opt,name=edge,proto3,oneof" json:"edge,omitempty"`
-	Width         *int32                 `protobuf:"varint,3,opt,name=width,proto3,oneof" json:"width,omitempty"`
-	Length        *int32                 `protobuf:"varint,4,opt,name=length,proto3,oneof" json:"length,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Diameter         *int32                 `protobuf:"varint,1,opt,name=diameter,proto3,oneof" json:"diameter,omitempty"`
+	Edge             *int32                 `protobuf:"varint,2,opt,name=edge,proto3,oneof" json:"edge,omitempty"`
+	Width            *int32                 `protobuf:"varint,3,opt,name=width,proto3,oneof" json:"width,omitempty"`
+	Length           *int32                 `protobuf:"varint,4,opt,name=length,proto3,oneof" json:"length,omitempty"`
+	MajorAxis        *int32                 `protobuf:"varint,5,opt,name=majorAxis,proto3,oneof" json:"majorAxis,omitempty"`
+	MinorAxis        *int32                 `protobuf:"varint,6,opt,name=minorAxis,proto3,oneof" json:"minorAxis,omitempty"`
+	DiameterDecimal  *float64               `protobuf:"fixed64,7,opt,name=diameterDecimal,proto3,oneof" json:"diameterDecimal,omitempty"`
+	EdgeDecimal      *float64               `protobuf:"fixed64,8,opt,name=edgeDecimal,proto3,oneof" json:"edgeDecimal,omitempty"`
+	WidthDecimal     *float64               `protobuf:"fixed64,9,opt,name=widthDecimal,proto3,oneof" json:"widthDecimal,omitempty"`
+	LengthDecimal    *float64               `protobuf:"fixed64,10,opt,name=lengthDecimal,proto3,oneof" json:"lengthDecimal,omitempty"`
+	MajorAxisDecimal *float64               `protobuf:"fixed64,11,opt,name=majorAxisDecimal,proto3,oneof" json:"majorAxisDecimal,omitempty"`
+	MinorAxisDecimal *float64               `protobuf:"fixed64,12,opt,name=minorAxisDecimal,proto3,oneof" json:"minorAxisDecimal,omitempty"`
+	Depth            *float64               `protobuf:"fixed64,13,opt,name=depth,proto3,oneof" json:"depth,omitempty"`
+	TopDiameter      *float64               `protobuf:"fixed64,14,opt,name=topDiameter,proto3,oneof" json:"topDiameter,omitempty"`
+	TopEdge          *float64               `protobuf:"fixed64,15,opt,name=topEdge,proto3,oneof" json:"topEdge,omitempty"`
+	TopWidth         *float64               `protobuf:"fixed64,16,opt,name=topWidth,proto3,oneof" json:"topWidth,omitempty"`
+	TopLength        *float64               `protobuf:"fixed64,17,opt,name=topLength,proto3,oneof" json:"topLength,omitempty"`
+	CornerRadius     *float64               `protobuf:"fixed64,18,opt,name=cornerRadius,proto3,oneof" json:"cornerRadius,omitempty"`
+	OuterDiameter    *float64               `protobuf:"fixed64,19,opt,name=outerDiameter,proto3,oneof" json:"outerDiameter,omitempty"`
+	InnerDiameter    *float64               `protobuf:"fixed64,20,opt,name=innerDiameter,proto3,oneof" json:"innerDiameter,omitempty"`
+	Vertices         []*PointProto          `protobuf:"bytes,21,rep,name=vertices,proto3" json:"vertices,omitempty"`
+	Sides            *int32                 `protobuf:"varint,22,opt,name=sides,proto3,oneof" json:"sides,omitempty"`
+	Circumradius     *float64               `protobuf:"fixed64,23,opt,name=circumradius,proto3,oneof" json:"circumradius,omitempty"`
+	SideA            *float64               `protobuf:"fixed64,24,opt,name=sideA,proto3,oneof" json:"sideA,omitempty"`
+	SideB            *float64               `protobuf:"fixed64,25,opt,name=sideB,proto3,oneof" json:"sideB,omitempty"`
+	SideC            *float64               `protobuf:"fixed64,26,opt,name=sideC,proto3,oneof" json:"sideC,omitempty"`
+	Unit             string                 `protobuf:"bytes,27,opt,name=unit,proto3" json:"unit,omitempty"`
+	WallThickness    *float64               `protobuf:"fixed64,28,opt,name=wallThickness,proto3,oneof" json:"wallThickness,omitempty"`
+	CrustBorder      *float64               `protobuf:"fixed64,29,opt,name=crustBorder,proto3,oneof" json:"crustBorder,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *MeasuresProto) Reset() {
@@ -89,19 +114,261 @@ func (x *MeasuresProto) GetLength() int32 {
 	return 0
 }
 
-type PanProto struct {
+func (x *MeasuresProto) GetMajorAxis() int32 {
+	if x != nil && x.MajorAxis != nil {
+		return *x.MajorAxis
+	}
+	return 0
+}
+
+func (x *MeasuresProto) GetMinorAxis() int32 {
+	if x != nil && x.MinorAxis != nil {
+		return *x.MinorAxis
+	}
+	return 0
+}
+
+func (x *MeasuresProto) GetDiameterDecimal() float64 {
+	if x != nil && x.DiameterDecimal != nil {
+		return *x.DiameterDecimal
+	}
+	return 0
+}
+
+func (x *MeasuresProto) GetEdgeDecimal() float64 {
+	if x != nil && x.EdgeDecimal != nil {
+		return *x.EdgeDecimal
+	}
+	return 0
+}
+
+func (x *MeasuresProto) GetWidthDecimal() float64 {
+	if x != nil && x.WidthDecimal != nil {
+		return *x.WidthDecimal
+	}
+	return 0
+}
+
+func (x *MeasuresProto) GetLengthDecimal() float64 {
+	if x != nil && x.LengthDecimal != nil {
+		return *x.LengthDecimal
+	}
+	return 0
+}
+
+func (x *MeasuresProto) GetMajorAxisDecimal() float64 {
+	if x != nil && x.MajorAxisDecimal != nil {
+		return *x.MajorAxisDecimal
+	}
+	return 0
+}
+
+func (x *MeasuresProto) GetMinorAxisDecimal() float64 {
+	if x != nil && x.MinorAxisDecimal != nil {
+		return *x.MinorAxisDecimal
+	}
+	return 0
+}
+
+func (x *MeasuresProto) GetDepth() float64 {
+	if x != nil && x.Depth != nil {
+		return *x.Depth
+	}
+	return 0
+}
+
+func (x *MeasuresProto) GetTopDiameter() float64 {
+	if x != nil && x.TopDiameter != nil {
+		return *x.TopDiameter
+	}
+	return 0
+}
+
+func (x *MeasuresProto) GetTopEdge() float64 {
+	if x != nil && x.TopEdge != nil {
+		return *x.TopEdge
+	}
+	return 0
+}
+
+func (x *MeasuresProto) GetTopWidth() float64 {
+	if x != nil && x.TopWidth != nil {
+		return *x.TopWidth
+	}
+	return 0
+}
+
+func (x *MeasuresProto) GetTopLength() float64 {
+	if x != nil && x.TopLength != nil {
+		return *x.TopLength
+	}
+	return 0
+}
+
+func (x *MeasuresProto) GetCornerRadius() float64 {
+	if x != nil && x.CornerRadius != nil {
+		return *x.CornerRadius
+	}
+	return 0
+}
+
+func (x *MeasuresProto) GetOuterDiameter() float64 {
+	if x != nil && x.OuterDiameter != nil {
+		return *x.OuterDiameter
+	}
+	return 0
+}
+
+func (x *MeasuresProto) GetInnerDiameter() float64 {
+	if x != nil && x.InnerDiameter != nil {
+		return *x.InnerDiameter
+	}
+	return 0
+}
+
+func (x *MeasuresProto) GetVertices() []*PointProto {
+	if x != nil {
+		return x.Vertices
+	}
+	return nil
+}
+
+func (x *MeasuresProto) GetSides() int32 {
+	if x != nil && x.Sides != nil {
+		return *x.Sides
+	}
+	return 0
+}
+
+func (x *MeasuresProto) GetCircumradius() float64 {
+	if x != nil && x.Circumradius != nil {
+		return *x.Circumradius
+	}
+	return 0
+}
+
+func (x *MeasuresProto) GetSideA() float64 {
+	if x != nil && x.SideA != nil {
+		return *x.SideA
+	}
+	return 0
+}
+
+func (x *MeasuresProto) GetSideB() float64 {
+	if x != nil && x.SideB != nil {
+		return *x.SideB
+	}
+	return 0
+}
+
+func (x *MeasuresProto) GetSideC() float64 {
+	if x != nil && x.SideC != nil {
+		return *x.SideC
+	}
+	return 0
+}
+
+func (x *MeasuresProto) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *MeasuresProto) GetWallThickness() float64 {
+	if x != nil && x.WallThickness != nil {
+		return *x.WallThickness
+	}
+	return 0
+}
+
+func (x *MeasuresProto) GetCrustBorder() float64 {
+	if x != nil && x.CrustBorder != nil {
+		return *x.CrustBorder
+	}
+	return 0
+}
+
+type PointProto struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Shape         string                 `protobuf:"bytes,1,opt,name=shape,proto3" json:"shape,omitempty"`
-	Measures      *MeasuresProto         `protobuf:"bytes,2,opt,name=measures,proto3" json:"measures,omitempty"`
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Area          float64                `protobuf:"fixed64,4,opt,name=area,proto3" json:"area,omitempty"`
+	X             float64                `protobuf:"fixed64,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y             float64                `protobuf:"fixed64,2,opt,name=y,proto3" json:"y,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
+func (x *PointProto) Reset() {
+	*x = PointProto{}
+	mi := &file_pkg_infrastructure_grpc_proto_calculator_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PointProto) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PointProto) ProtoMessage() {}
+
+func (x *PointProto) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_infrastructure_grpc_proto_calculator_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PointProto.ProtoReflect.Descriptor instead.
+func (*PointProto) Descriptor() ([]byte, []int) {
+	return file_pkg_infrastructure_grpc_proto_calculator_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PointProto) GetX() float64 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *PointProto) GetY() float64 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+type PanProto struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Shape           string                 `protobuf:"bytes,1,opt,name=shape,proto3" json:"shape,omitempty"`
+	Measures        *MeasuresProto         `protobuf:"bytes,2,opt,name=measures,proto3" json:"measures,omitempty"`
+	Name            string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Area            float64                `protobuf:"fixed64,4,opt,name=area,proto3" json:"area,omitempty"`
+	DoughWeight     float64                `protobuf:"fixed64,5,opt,name=doughWeight,proto3" json:"doughWeight,omitempty"`
+	SideArea        float64                `protobuf:"fixed64,6,opt,name=sideArea,proto3" json:"sideArea,omitempty"`
+	Volume          float64                `protobuf:"fixed64,7,opt,name=volume,proto3" json:"volume,omitempty"`
+	Warnings        []string               `protobuf:"bytes,8,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	Quantity        int32                  `protobuf:"varint,9,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	LineArea        float64                `protobuf:"fixed64,10,opt,name=lineArea,proto3" json:"lineArea,omitempty"`
+	LineDoughWeight float64                `protobuf:"fixed64,11,opt,name=lineDoughWeight,proto3" json:"lineDoughWeight,omitempty"`
+	CatalogId       string                 `protobuf:"bytes,12,opt,name=catalogId,proto3" json:"catalogId,omitempty"`
+	Error           *PanErrorProto         `protobuf:"bytes,13,opt,name=error,proto3" json:"error,omitempty"`
+	DoughArea       float64                `protobuf:"fixed64,14,opt,name=doughArea,proto3" json:"doughArea,omitempty"`
+	ToppingArea     float64                `protobuf:"fixed64,15,opt,name=toppingArea,proto3" json:"toppingArea,omitempty"`
+	Ingredients     *IngredientsProto      `protobuf:"bytes,16,opt,name=ingredients,proto3" json:"ingredients,omitempty"`
+	BallCount       int32                  `protobuf:"varint,17,opt,name=ballCount,proto3" json:"ballCount,omitempty"`
+	BallWeight      float64                `protobuf:"fixed64,18,opt,name=ballWeight,proto3" json:"ballWeight,omitempty"`
+	DoughOnWalls    bool                   `protobuf:"varint,19,opt,name=doughOnWalls,proto3" json:"doughOnWalls,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
 func (x *PanProto) Reset() {
 	*x = PanProto{}
-	mi := &file_pkg_infrastructure_grpc_proto_calculator_proto_msgTypes[1]
+	mi := &file_pkg_infrastructure_grpc_proto_calculator_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -113,7 +380,7 @@ func (x *PanProto) String() string {
 func (*PanProto) ProtoMessage() {}
 
 func (x *PanProto) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_infrastructure_grpc_proto_calculator_proto_msgTypes[1]
+	mi := &file_pkg_infrastructure_grpc_proto_calculator_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -126,7 +393,7 @@ func (x *PanProto) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PanProto.ProtoReflect.Descriptor instead.
 func (*PanProto) Descriptor() ([]byte, []int) {
-	return file_pkg_infrastructure_grpc_proto_calculator_proto_rawDescGZIP(), []int{1}
+	return file_pkg_infrastructure_grpc_proto_calculator_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *PanProto) GetShape() string {
@@ -157,124 +424,135 @@ func (x *PanProto) GetArea() float64 {
 	return 0
 }
 
-type PansProto struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Pans          []*PanProto            `protobuf:"bytes,1,rep,name=pans,proto3" json:"pans,omitempty"`
-	TotalArea     float64                `protobuf:"fixed64,2,opt,name=totalArea,proto3" json:"totalArea,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *PanProto) GetDoughWeight() float64 {
+	if x != nil {
+		return x.DoughWeight
+	}
+	return 0
 }
 
-func (x *PansProto) Reset() {
-	*x = PansProto{}
-	mi := &file_pkg_infrastructure_grpc_proto_calculator_proto_msgTypes[2]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *PanProto) GetSideArea() float64 {
+	if x != nil {
+		return x.SideArea
+	}
+	return 0
 }
 
-func (x *PansProto) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *PanProto) GetVolume() float64 {
+	if x != nil {
+		return x.Volume
+	}
+	return 0
 }
 
-func (*PansProto) ProtoMessage() {}
-
-func (x *PansProto) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_infrastructure_grpc_proto_calculator_proto_msgTypes[2]
+func (x *PanProto) GetWarnings() []string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Warnings
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use PansProto.ProtoReflect.Descriptor instead.
-func (*PansProto) Descriptor() ([]byte, []int) {
-	return file_pkg_infrastructure_grpc_proto_calculator_proto_rawDescGZIP(), []int{2}
+func (x *PanProto) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
 }
 
-func (x *PansProto) GetPans() []*PanProto {
+func (x *PanProto) GetLineArea() float64 {
 	if x != nil {
-		return x.Pans
+		return x.LineArea
 	}
-	return nil
+	return 0
 }
 
-func (x *PansProto) GetTotalArea() float64 {
+func (x *PanProto) GetLineDoughWeight() float64 {
 	if x != nil {
-		return x.TotalArea
+		return x.LineDoughWeight
 	}
 	return 0
 }
 
-type PansRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Pans          *PansProto             `protobuf:"bytes,1,opt,name=pans,proto3" json:"pans,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *PanProto) GetCatalogId() string {
+	if x != nil {
+		return x.CatalogId
+	}
+	return ""
 }
 
-func (x *PansRequest) Reset() {
-	*x = PansRequest{}
-	mi := &file_pkg_infrastructure_grpc_proto_calculator_proto_msgTypes[3]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *PanProto) GetError() *PanErrorProto {
+	if x != nil {
+		return x.Error
+	}
+	return nil
 }
 
-func (x *PansRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *PanProto) GetDoughArea() float64 {
+	if x != nil {
+		return x.DoughArea
+	}
+	return 0
 }
 
-func (*PansRequest) ProtoMessage() {}
+func (x *PanProto) GetToppingArea() float64 {
+	if x != nil {
+		return x.ToppingArea
+	}
+	return 0
+}
 
-func (x *PansRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_infrastructure_grpc_proto_calculator_proto_msgTypes[3]
+func (x *PanProto) GetIngredients() *IngredientsProto {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Ingredients
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use PansRequest.ProtoReflect.Descriptor instead.
-func (*PansRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_infrastructure_grpc_proto_calculator_proto_rawDescGZIP(), []int{3}
+func (x *PanProto) GetBallCount() int32 {
+	if x != nil {
+		return x.BallCount
+	}
+	return 0
 }
 
-func (x *PansRequest) GetPans() *PansProto {
+func (x *PanProto) GetBallWeight() float64 {
 	if x != nil {
-		return x.Pans
+		return x.BallWeight
 	}
-	return nil
+	return 0
 }
 
-type PansResponse struct {
+func (x *PanProto) GetDoughOnWalls() bool {
+	if x != nil {
+		return x.DoughOnWalls
+	}
+	return false
+}
+
+type PanErrorProto struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Pans          *PansProto             `protobuf:"bytes,1,opt,name=pans,proto3" json:"pans,omitempty"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Fields        []string               `protobuf:"bytes,3,rep,name=fields,proto3" json:"fields,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PansResponse) Reset() {
-	*x = PansResponse{}
-	mi := &file_pkg_infrastructure_grpc_proto_calculator_proto_msgTypes[4]
+func (x *PanErrorProto) Reset() {
+	*x = PanErrorProto{}
+	mi := &file_pkg_infrastructure_grpc_proto_calculator_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PansResponse) String() string {
+func (x *PanErrorProto) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PansResponse) ProtoMessage() {}
+func (*PanErrorProto) ProtoMessage() {}
 
-func (x *PansResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_infrastructure_grpc_proto_calculator_proto_msgTypes[4]
+func (x *PanErrorProto) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_infrastructure_grpc_proto_calculator_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -285,25 +563,1671 @@ func (x *PansResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PansResponse.ProtoReflect.Descriptor instead.
-func (*PansResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_infrastructure_grpc_proto_calculator_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use PanErrorProto.ProtoReflect.Descriptor instead.
+func (*PanErrorProto) Descriptor() ([]byte, []int) {
+	return file_pkg_infrastructure_grpc_proto_calculator_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *PansResponse) GetPans() *PansProto {
+func (x *PanErrorProto) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *PanErrorProto) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *PanErrorProto) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type PansProto struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Pans             []*PanProto            `protobuf:"bytes,1,rep,name=pans,proto3" json:"pans,omitempty"`
+	TotalArea        float64                `protobuf:"fixed64,2,opt,name=totalArea,proto3" json:"totalArea,omitempty"`
+	TotalDoughWeight float64                `protobuf:"fixed64,3,opt,name=totalDoughWeight,proto3" json:"totalDoughWeight,omitempty"`
+	ThicknessFactor  *float64               `protobuf:"fixed64,4,opt,name=thicknessFactor,proto3,oneof" json:"thicknessFactor,omitempty"`
+	RiseFactor       *float64               `protobuf:"fixed64,5,opt,name=riseFactor,proto3,oneof" json:"riseFactor,omitempty"`
+	Ingredients      *IngredientsProto      `protobuf:"bytes,6,opt,name=ingredients,proto3" json:"ingredients,omitempty"`
+	Preferment       *IngredientsProto      `protobuf:"bytes,7,opt,name=preferment,proto3" json:"preferment,omitempty"`
+	FinalDough       *IngredientsProto      `protobuf:"bytes,8,opt,name=finalDough,proto3" json:"finalDough,omitempty"`
+	Flours           []*BlendFlourProto     `protobuf:"bytes,9,rep,name=flours,proto3" json:"flours,omitempty"`
+	WasteFactor      *float64               `protobuf:"fixed64,10,opt,name=wasteFactor,proto3,oneof" json:"wasteFactor,omitempty"`
+	ScalePrecision   *float64               `protobuf:"fixed64,11,opt,name=scalePrecision,proto3,oneof" json:"scalePrecision,omitempty"`
+	TotalBallWeight  float64                `protobuf:"fixed64,12,opt,name=totalBallWeight,proto3" json:"totalBallWeight,omitempty"`
+	MixDoughWeight   float64                `protobuf:"fixed64,13,opt,name=mixDoughWeight,proto3" json:"mixDoughWeight,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *PansProto) Reset() {
+	*x = PansProto{}
+	mi := &file_pkg_infrastructure_grpc_proto_calculator_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PansProto) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PansProto) ProtoMessage() {}
+
+func (x *PansProto) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_infrastructure_grpc_proto_calculator_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PansProto.ProtoReflect.Descriptor instead.
+func (*PansProto) Descriptor() ([]byte, []int) {
+	return file_pkg_infrastructure_grpc_proto_calculator_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PansProto) GetPans() []*PanProto {
+	if x != nil {
+		return x.Pans
+	}
+	return nil
+}
+
+func (x *PansProto) GetTotalArea() float64 {
+	if x != nil {
+		return x.TotalArea
+	}
+	return 0
+}
+
+func (x *PansProto) GetTotalDoughWeight() float64 {
+	if x != nil {
+		return x.TotalDoughWeight
+	}
+	return 0
+}
+
+func (x *PansProto) GetThicknessFactor() float64 {
+	if x != nil && x.ThicknessFactor != nil {
+		return *x.ThicknessFactor
+	}
+	return 0
+}
+
+func (x *PansProto) GetRiseFactor() float64 {
+	if x != nil && x.RiseFactor != nil {
+		return *x.RiseFactor
+	}
+	return 0
+}
+
+func (x *PansProto) GetIngredients() *IngredientsProto {
+	if x != nil {
+		return x.Ingredients
+	}
+	return nil
+}
+
+func (x *PansProto) GetPreferment() *IngredientsProto {
+	if x != nil {
+		return x.Preferment
+	}
+	return nil
+}
+
+func (x *PansProto) GetFinalDough() *IngredientsProto {
+	if x != nil {
+		return x.FinalDough
+	}
+	return nil
+}
+
+func (x *PansProto) GetFlours() []*BlendFlourProto {
+	if x != nil {
+		return x.Flours
+	}
+	return nil
+}
+
+func (x *PansProto) GetWasteFactor() float64 {
+	if x != nil && x.WasteFactor != nil {
+		return *x.WasteFactor
+	}
+	return 0
+}
+
+func (x *PansProto) GetScalePrecision() float64 {
+	if x != nil && x.ScalePrecision != nil {
+		return *x.ScalePrecision
+	}
+	return 0
+}
+
+func (x *PansProto) GetTotalBallWeight() float64 {
+	if x != nil {
+		return x.TotalBallWeight
+	}
+	return 0
+}
+
+func (x *PansProto) GetMixDoughWeight() float64 {
+	if x != nil {
+		return x.MixDoughWeight
+	}
+	return 0
+}
+
+type FormulaProto struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hydration     *float64               `protobuf:"fixed64,1,opt,name=hydration,proto3,oneof" json:"hydration,omitempty"`
+	Salt          *float64               `protobuf:"fixed64,2,opt,name=salt,proto3,oneof" json:"salt,omitempty"`
+	Yeast         *float64               `protobuf:"fixed64,3,opt,name=yeast,proto3,oneof" json:"yeast,omitempty"`
+	Oil           *float64               `protobuf:"fixed64,4,opt,name=oil,proto3,oneof" json:"oil,omitempty"`
+	Sugar         *float64               `protobuf:"fixed64,5,opt,name=sugar,proto3,oneof" json:"sugar,omitempty"`
+	YeastType     string                 `protobuf:"bytes,6,opt,name=yeastType,proto3" json:"yeastType,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FormulaProto) Reset() {
+	*x = FormulaProto{}
+	mi := &file_pkg_infrastructure_grpc_proto_calculator_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FormulaProto) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FormulaProto) ProtoMessage() {}
+
+func (x *FormulaProto) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_infrastructure_grpc_proto_calculator_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FormulaProto.ProtoReflect.Descriptor instead.
+func (*FormulaProto) Descriptor() ([]byte, []int) {
+	return file_pkg_infrastructure_grpc_proto_calculator_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *FormulaProto) GetHydration() float64 {
+	if x != nil && x.Hydration != nil {
+		return *x.Hydration
+	}
+	return 0
+}
+
+func (x *FormulaProto) GetSalt() float64 {
+	if x != nil && x.Salt != nil {
+		return *x.Salt
+	}
+	return 0
+}
+
+func (x *FormulaProto) GetYeast() float64 {
+	if x != nil && x.Yeast != nil {
+		return *x.Yeast
+	}
+	return 0
+}
+
+func (x *FormulaProto) GetOil() float64 {
+	if x != nil && x.Oil != nil {
+		return *x.Oil
+	}
+	return 0
+}
+
+func (x *FormulaProto) GetSugar() float64 {
+	if x != nil && x.Sugar != nil {
+		return *x.Sugar
+	}
+	return 0
+}
+
+func (x *FormulaProto) GetYeastType() string {
+	if x != nil {
+		return x.YeastType
+	}
+	return ""
+}
+
+type FermentationProto struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hours         float64                `protobuf:"fixed64,1,opt,name=hours,proto3" json:"hours,omitempty"`
+	Temperature   float64                `protobuf:"fixed64,2,opt,name=temperature,proto3" json:"temperature,omitempty"`
+	YeastType     string                 `protobuf:"bytes,3,opt,name=yeastType,proto3" json:"yeastType,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FermentationProto) Reset() {
+	*x = FermentationProto{}
+	mi := &file_pkg_infrastructure_grpc_proto_calculator_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FermentationProto) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FermentationProto) ProtoMessage() {}
+
+func (x *FermentationProto) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_infrastructure_grpc_proto_calculator_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FermentationProto.ProtoReflect.Descriptor instead.
+func (*FermentationProto) Descriptor() ([]byte, []int) {
+	return file_pkg_infrastructure_grpc_proto_calculator_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *FermentationProto) GetHours() float64 {
+	if x != nil {
+		return x.Hours
+	}
+	return 0
+}
+
+func (x *FermentationProto) GetTemperature() float64 {
+	if x != nil {
+		return x.Temperature
+	}
+	return 0
+}
+
+func (x *FermentationProto) GetYeastType() string {
+	if x != nil {
+		return x.YeastType
+	}
+	return ""
+}
+
+type IngredientsProto struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Flour         float64                `protobuf:"fixed64,1,opt,name=flour,proto3" json:"flour,omitempty"`
+	Water         float64                `protobuf:"fixed64,2,opt,name=water,proto3" json:"water,omitempty"`
+	Salt          float64                `protobuf:"fixed64,3,opt,name=salt,proto3" json:"salt,omitempty"`
+	Yeast         float64                `protobuf:"fixed64,4,opt,name=yeast,proto3" json:"yeast,omitempty"`
+	Oil           float64                `protobuf:"fixed64,5,opt,name=oil,proto3" json:"oil,omitempty"`
+	Sugar         float64                `protobuf:"fixed64,6,opt,name=sugar,proto3" json:"sugar,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IngredientsProto) Reset() {
+	*x = IngredientsProto{}
+	mi := &file_pkg_infrastructure_grpc_proto_calculator_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IngredientsProto) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IngredientsProto) ProtoMessage() {}
+
+func (x *IngredientsProto) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_infrastructure_grpc_proto_calculator_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IngredientsProto.ProtoReflect.Descriptor instead.
+func (*IngredientsProto) Descriptor() ([]byte, []int) {
+	return file_pkg_infrastructure_grpc_proto_calculator_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *IngredientsProto) GetFlour() float64 {
+	if x != nil {
+		return x.Flour
+	}
+	return 0
+}
+
+func (x *IngredientsProto) GetWater() float64 {
+	if x != nil {
+		return x.Water
+	}
+	return 0
+}
+
+func (x *IngredientsProto) GetSalt() float64 {
+	if x != nil {
+		return x.Salt
+	}
+	return 0
+}
+
+func (x *IngredientsProto) GetYeast() float64 {
+	if x != nil {
+		return x.Yeast
+	}
+	return 0
+}
+
+func (x *IngredientsProto) GetOil() float64 {
+	if x != nil {
+		return x.Oil
+	}
+	return 0
+}
+
+func (x *IngredientsProto) GetSugar() float64 {
+	if x != nil {
+		return x.Sugar
+	}
+	return 0
+}
+
+type PansRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pans          *PansProto             `protobuf:"bytes,1,opt,name=pans,proto3" json:"pans,omitempty"`
+	Unit          string                 `protobuf:"bytes,2,opt,name=unit,proto3" json:"unit,omitempty"`
+	Partial       bool                   `protobuf:"varint,3,opt,name=partial,proto3" json:"partial,omitempty"`
+	Strict        bool                   `protobuf:"varint,4,opt,name=strict,proto3" json:"strict,omitempty"`
+	Style         string                 `protobuf:"bytes,5,opt,name=style,proto3" json:"style,omitempty"`
+	Formula       *FormulaProto          `protobuf:"bytes,6,opt,name=formula,proto3" json:"formula,omitempty"`
+	Fermentation  *FermentationProto     `protobuf:"bytes,7,opt,name=fermentation,proto3" json:"fermentation,omitempty"`
+	Preferment    *PrefermentProto       `protobuf:"bytes,8,opt,name=preferment,proto3" json:"preferment,omitempty"`
+	Blend         []*BlendPartProto      `protobuf:"bytes,9,rep,name=blend,proto3" json:"blend,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PansRequest) Reset() {
+	*x = PansRequest{}
+	mi := &file_pkg_infrastructure_grpc_proto_calculator_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PansRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PansRequest) ProtoMessage() {}
+
+func (x *PansRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_infrastructure_grpc_proto_calculator_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PansRequest.ProtoReflect.Descriptor instead.
+func (*PansRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_infrastructure_grpc_proto_calculator_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PansRequest) GetPans() *PansProto {
+	if x != nil {
+		return x.Pans
+	}
+	return nil
+}
+
+func (x *PansRequest) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *PansRequest) GetPartial() bool {
+	if x != nil {
+		return x.Partial
+	}
+	return false
+}
+
+func (x *PansRequest) GetStrict() bool {
+	if x != nil {
+		return x.Strict
+	}
+	return false
+}
+
+func (x *PansRequest) GetStyle() string {
+	if x != nil {
+		return x.Style
+	}
+	return ""
+}
+
+func (x *PansRequest) GetFormula() *FormulaProto {
+	if x != nil {
+		return x.Formula
+	}
+	return nil
+}
+
+func (x *PansRequest) GetFermentation() *FermentationProto {
+	if x != nil {
+		return x.Fermentation
+	}
+	return nil
+}
+
+func (x *PansRequest) GetPreferment() *PrefermentProto {
+	if x != nil {
+		return x.Preferment
+	}
+	return nil
+}
+
+func (x *PansRequest) GetBlend() []*BlendPartProto {
+	if x != nil {
+		return x.Blend
+	}
+	return nil
+}
+
+type PansResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Pans                *PansProto             `protobuf:"bytes,1,opt,name=pans,proto3" json:"pans,omitempty"`
+	Unit                string                 `protobuf:"bytes,2,opt,name=unit,proto3" json:"unit,omitempty"`
+	Style               *StyleProto            `protobuf:"bytes,3,opt,name=style,proto3" json:"style,omitempty"`
+	Formula             *FormulaProto          `protobuf:"bytes,4,opt,name=formula,proto3" json:"formula,omitempty"`
+	Preferment          *PrefermentProto       `protobuf:"bytes,5,opt,name=preferment,proto3" json:"preferment,omitempty"`
+	HydrationAdjustment float64                `protobuf:"fixed64,6,opt,name=hydrationAdjustment,proto3" json:"hydrationAdjustment,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *PansResponse) Reset() {
+	*x = PansResponse{}
+	mi := &file_pkg_infrastructure_grpc_proto_calculator_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PansResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PansResponse) ProtoMessage() {}
+
+func (x *PansResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_infrastructure_grpc_proto_calculator_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PansResponse.ProtoReflect.Descriptor instead.
+func (*PansResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_infrastructure_grpc_proto_calculator_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *PansResponse) GetPans() *PansProto {
+	if x != nil {
+		return x.Pans
+	}
+	return nil
+}
+
+func (x *PansResponse) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *PansResponse) GetStyle() *StyleProto {
+	if x != nil {
+		return x.Style
+	}
+	return nil
+}
+
+func (x *PansResponse) GetFormula() *FormulaProto {
+	if x != nil {
+		return x.Formula
+	}
+	return nil
+}
+
+func (x *PansResponse) GetPreferment() *PrefermentProto {
+	if x != nil {
+		return x.Preferment
+	}
+	return nil
+}
+
+func (x *PansResponse) GetHydrationAdjustment() float64 {
+	if x != nil {
+		return x.HydrationAdjustment
+	}
+	return 0
+}
+
+type PrefermentProto struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	FlourShare    float64                `protobuf:"fixed64,2,opt,name=flourShare,proto3" json:"flourShare,omitempty"`
+	Hydration     *float64               `protobuf:"fixed64,3,opt,name=hydration,proto3,oneof" json:"hydration,omitempty"`
+	Inoculation   float64                `protobuf:"fixed64,4,opt,name=inoculation,proto3" json:"inoculation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PrefermentProto) Reset() {
+	*x = PrefermentProto{}
+	mi := &file_pkg_infrastructure_grpc_proto_calculator_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrefermentProto) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrefermentProto) ProtoMessage() {}
+
+func (x *PrefermentProto) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_infrastructure_grpc_proto_calculator_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrefermentProto.ProtoReflect.Descriptor instead.
+func (*PrefermentProto) Descriptor() ([]byte, []int) {
+	return file_pkg_infrastructure_grpc_proto_calculator_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *PrefermentProto) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *PrefermentProto) GetFlourShare() float64 {
+	if x != nil {
+		return x.FlourShare
+	}
+	return 0
+}
+
+func (x *PrefermentProto) GetHydration() float64 {
+	if x != nil && x.Hydration != nil {
+		return *x.Hydration
+	}
+	return 0
+}
+
+func (x *PrefermentProto) GetInoculation() float64 {
+	if x != nil {
+		return x.Inoculation
+	}
+	return 0
+}
+
+type StyleProto struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description     string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	ThicknessFactor float64                `protobuf:"fixed64,4,opt,name=thicknessFactor,proto3" json:"thicknessFactor,omitempty"`
+	Hydration       float64                `protobuf:"fixed64,5,opt,name=hydration,proto3" json:"hydration,omitempty"`
+	Salt            float64                `protobuf:"fixed64,6,opt,name=salt,proto3" json:"salt,omitempty"`
+	Oil             float64                `protobuf:"fixed64,7,opt,name=oil,proto3" json:"oil,omitempty"`
+	Sugar           float64                `protobuf:"fixed64,8,opt,name=sugar,proto3" json:"sugar,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *StyleProto) Reset() {
+	*x = StyleProto{}
+	mi := &file_pkg_infrastructure_grpc_proto_calculator_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StyleProto) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StyleProto) ProtoMessage() {}
+
+func (x *StyleProto) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_infrastructure_grpc_proto_calculator_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StyleProto.ProtoReflect.Descriptor instead.
+func (*StyleProto) Descriptor() ([]byte, []int) {
+	return file_pkg_infrastructure_grpc_proto_calculator_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *StyleProto) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *StyleProto) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StyleProto) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *StyleProto) GetThicknessFactor() float64 {
+	if x != nil {
+		return x.ThicknessFactor
+	}
+	return 0
+}
+
+func (x *StyleProto) GetHydration() float64 {
+	if x != nil {
+		return x.Hydration
+	}
+	return 0
+}
+
+func (x *StyleProto) GetSalt() float64 {
+	if x != nil {
+		return x.Salt
+	}
+	return 0
+}
+
+func (x *StyleProto) GetOil() float64 {
+	if x != nil {
+		return x.Oil
+	}
+	return 0
+}
+
+func (x *StyleProto) GetSugar() float64 {
+	if x != nil {
+		return x.Sugar
+	}
+	return 0
+}
+
+type ListStylesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStylesRequest) Reset() {
+	*x = ListStylesRequest{}
+	mi := &file_pkg_infrastructure_grpc_proto_calculator_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStylesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStylesRequest) ProtoMessage() {}
+
+func (x *ListStylesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_infrastructure_grpc_proto_calculator_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStylesRequest.ProtoReflect.Descriptor instead.
+func (*ListStylesRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_infrastructure_grpc_proto_calculator_proto_rawDescGZIP(), []int{12}
+}
+
+type ListStylesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Styles        []*StyleProto          `protobuf:"bytes,1,rep,name=styles,proto3" json:"styles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStylesResponse) Reset() {
+	*x = ListStylesResponse{}
+	mi := &file_pkg_infrastructure_grpc_proto_calculator_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStylesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStylesResponse) ProtoMessage() {}
+
+func (x *ListStylesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_infrastructure_grpc_proto_calculator_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStylesResponse.ProtoReflect.Descriptor instead.
+func (*ListStylesResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_infrastructure_grpc_proto_calculator_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListStylesResponse) GetStyles() []*StyleProto {
+	if x != nil {
+		return x.Styles
+	}
+	return nil
+}
+
+type FlourProto struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Absorption    float64                `protobuf:"fixed64,3,opt,name=absorption,proto3" json:"absorption,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FlourProto) Reset() {
+	*x = FlourProto{}
+	mi := &file_pkg_infrastructure_grpc_proto_calculator_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlourProto) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlourProto) ProtoMessage() {}
+
+func (x *FlourProto) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_infrastructure_grpc_proto_calculator_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlourProto.ProtoReflect.Descriptor instead.
+func (*FlourProto) Descriptor() ([]byte, []int) {
+	return file_pkg_infrastructure_grpc_proto_calculator_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *FlourProto) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *FlourProto) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FlourProto) GetAbsorption() float64 {
+	if x != nil {
+		return x.Absorption
+	}
+	return 0
+}
+
+type ListFloursRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFloursRequest) Reset() {
+	*x = ListFloursRequest{}
+	mi := &file_pkg_infrastructure_grpc_proto_calculator_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFloursRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFloursRequest) ProtoMessage() {}
+
+func (x *ListFloursRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_infrastructure_grpc_proto_calculator_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFloursRequest.ProtoReflect.Descriptor instead.
+func (*ListFloursRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_infrastructure_grpc_proto_calculator_proto_rawDescGZIP(), []int{15}
+}
+
+type ListFloursResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Flours        []*FlourProto          `protobuf:"bytes,1,rep,name=flours,proto3" json:"flours,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFloursResponse) Reset() {
+	*x = ListFloursResponse{}
+	mi := &file_pkg_infrastructure_grpc_proto_calculator_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFloursResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFloursResponse) ProtoMessage() {}
+
+func (x *ListFloursResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_infrastructure_grpc_proto_calculator_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFloursResponse.ProtoReflect.Descriptor instead.
+func (*ListFloursResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_infrastructure_grpc_proto_calculator_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListFloursResponse) GetFlours() []*FlourProto {
+	if x != nil {
+		return x.Flours
+	}
+	return nil
+}
+
+type BlendPartProto struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Flour         string                 `protobuf:"bytes,1,opt,name=flour,proto3" json:"flour,omitempty"`
+	Share         float64                `protobuf:"fixed64,2,opt,name=share,proto3" json:"share,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlendPartProto) Reset() {
+	*x = BlendPartProto{}
+	mi := &file_pkg_infrastructure_grpc_proto_calculator_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlendPartProto) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlendPartProto) ProtoMessage() {}
+
+func (x *BlendPartProto) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_infrastructure_grpc_proto_calculator_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlendPartProto.ProtoReflect.Descriptor instead.
+func (*BlendPartProto) Descriptor() ([]byte, []int) {
+	return file_pkg_infrastructure_grpc_proto_calculator_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *BlendPartProto) GetFlour() string {
+	if x != nil {
+		return x.Flour
+	}
+	return ""
+}
+
+func (x *BlendPartProto) GetShare() float64 {
+	if x != nil {
+		return x.Share
+	}
+	return 0
+}
+
+type BlendFlourProto struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Flour         *FlourProto            `protobuf:"bytes,1,opt,name=flour,proto3" json:"flour,omitempty"`
+	Share         float64                `protobuf:"fixed64,2,opt,name=share,proto3" json:"share,omitempty"`
+	Weight        float64                `protobuf:"fixed64,3,opt,name=weight,proto3" json:"weight,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlendFlourProto) Reset() {
+	*x = BlendFlourProto{}
+	mi := &file_pkg_infrastructure_grpc_proto_calculator_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlendFlourProto) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlendFlourProto) ProtoMessage() {}
+
+func (x *BlendFlourProto) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_infrastructure_grpc_proto_calculator_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlendFlourProto.ProtoReflect.Descriptor instead.
+func (*BlendFlourProto) Descriptor() ([]byte, []int) {
+	return file_pkg_infrastructure_grpc_proto_calculator_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *BlendFlourProto) GetFlour() *FlourProto {
+	if x != nil {
+		return x.Flour
+	}
+	return nil
+}
+
+func (x *BlendFlourProto) GetShare() float64 {
+	if x != nil {
+		return x.Share
+	}
+	return 0
+}
+
+func (x *BlendFlourProto) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+type ListShapesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Unit          string                 `protobuf:"bytes,1,opt,name=unit,proto3" json:"unit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListShapesRequest) Reset() {
+	*x = ListShapesRequest{}
+	mi := &file_pkg_infrastructure_grpc_proto_calculator_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListShapesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListShapesRequest) ProtoMessage() {}
+
+func (x *ListShapesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_infrastructure_grpc_proto_calculator_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListShapesRequest.ProtoReflect.Descriptor instead.
+func (*ListShapesRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_infrastructure_grpc_proto_calculator_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListShapesRequest) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+type MeasureSpecProto struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Required      bool                   `protobuf:"varint,2,opt,name=required,proto3" json:"required,omitempty"`
+	Unit          string                 `protobuf:"bytes,3,opt,name=unit,proto3" json:"unit,omitempty"`
+	Min           float64                `protobuf:"fixed64,4,opt,name=min,proto3" json:"min,omitempty"`
+	Max           float64                `protobuf:"fixed64,5,opt,name=max,proto3" json:"max,omitempty"`
+	Description   string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	ConflictsWith []string               `protobuf:"bytes,7,rep,name=conflictsWith,proto3" json:"conflictsWith,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MeasureSpecProto) Reset() {
+	*x = MeasureSpecProto{}
+	mi := &file_pkg_infrastructure_grpc_proto_calculator_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MeasureSpecProto) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeasureSpecProto) ProtoMessage() {}
+
+func (x *MeasureSpecProto) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_infrastructure_grpc_proto_calculator_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeasureSpecProto.ProtoReflect.Descriptor instead.
+func (*MeasureSpecProto) Descriptor() ([]byte, []int) {
+	return file_pkg_infrastructure_grpc_proto_calculator_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *MeasureSpecProto) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MeasureSpecProto) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *MeasureSpecProto) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *MeasureSpecProto) GetMin() float64 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *MeasureSpecProto) GetMax() float64 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+func (x *MeasureSpecProto) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *MeasureSpecProto) GetConflictsWith() []string {
+	if x != nil {
+		return x.ConflictsWith
+	}
+	return nil
+}
+
+type ShapeProto struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Aliases       []string               `protobuf:"bytes,2,rep,name=aliases,proto3" json:"aliases,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Measures      []*MeasureSpecProto    `protobuf:"bytes,4,rep,name=measures,proto3" json:"measures,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShapeProto) Reset() {
+	*x = ShapeProto{}
+	mi := &file_pkg_infrastructure_grpc_proto_calculator_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShapeProto) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShapeProto) ProtoMessage() {}
+
+func (x *ShapeProto) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_infrastructure_grpc_proto_calculator_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShapeProto.ProtoReflect.Descriptor instead.
+func (*ShapeProto) Descriptor() ([]byte, []int) {
+	return file_pkg_infrastructure_grpc_proto_calculator_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ShapeProto) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ShapeProto) GetAliases() []string {
+	if x != nil {
+		return x.Aliases
+	}
+	return nil
+}
+
+func (x *ShapeProto) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ShapeProto) GetMeasures() []*MeasureSpecProto {
+	if x != nil {
+		return x.Measures
+	}
+	return nil
+}
+
+type ListShapesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Shapes        []*ShapeProto          `protobuf:"bytes,1,rep,name=shapes,proto3" json:"shapes,omitempty"`
+	Unit          string                 `protobuf:"bytes,2,opt,name=unit,proto3" json:"unit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListShapesResponse) Reset() {
+	*x = ListShapesResponse{}
+	mi := &file_pkg_infrastructure_grpc_proto_calculator_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListShapesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListShapesResponse) ProtoMessage() {}
+
+func (x *ListShapesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_infrastructure_grpc_proto_calculator_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListShapesResponse.ProtoReflect.Descriptor instead.
+func (*ListShapesResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_infrastructure_grpc_proto_calculator_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListShapesResponse) GetShapes() []*ShapeProto {
+	if x != nil {
+		return x.Shapes
+	}
+	return nil
+}
+
+func (x *ListShapesResponse) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+type CatalogPanProto struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Shape         string                 `protobuf:"bytes,2,opt,name=shape,proto3" json:"shape,omitempty"`
+	Measures      *MeasuresProto         `protobuf:"bytes,3,opt,name=measures,proto3" json:"measures,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CatalogPanProto) Reset() {
+	*x = CatalogPanProto{}
+	mi := &file_pkg_infrastructure_grpc_proto_calculator_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CatalogPanProto) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CatalogPanProto) ProtoMessage() {}
+
+func (x *CatalogPanProto) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_infrastructure_grpc_proto_calculator_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CatalogPanProto.ProtoReflect.Descriptor instead.
+func (*CatalogPanProto) Descriptor() ([]byte, []int) {
+	return file_pkg_infrastructure_grpc_proto_calculator_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CatalogPanProto) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CatalogPanProto) GetShape() string {
+	if x != nil {
+		return x.Shape
+	}
+	return ""
+}
+
+func (x *CatalogPanProto) GetMeasures() *MeasuresProto {
+	if x != nil {
+		return x.Measures
+	}
+	return nil
+}
+
+func (x *CatalogPanProto) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type CatalogPanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pan           *CatalogPanProto       `protobuf:"bytes,1,opt,name=pan,proto3" json:"pan,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CatalogPanRequest) Reset() {
+	*x = CatalogPanRequest{}
+	mi := &file_pkg_infrastructure_grpc_proto_calculator_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CatalogPanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CatalogPanRequest) ProtoMessage() {}
+
+func (x *CatalogPanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_infrastructure_grpc_proto_calculator_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CatalogPanRequest.ProtoReflect.Descriptor instead.
+func (*CatalogPanRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_infrastructure_grpc_proto_calculator_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CatalogPanRequest) GetPan() *CatalogPanProto {
+	if x != nil {
+		return x.Pan
+	}
+	return nil
+}
+
+type CatalogPanIdRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CatalogPanIdRequest) Reset() {
+	*x = CatalogPanIdRequest{}
+	mi := &file_pkg_infrastructure_grpc_proto_calculator_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CatalogPanIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CatalogPanIdRequest) ProtoMessage() {}
+
+func (x *CatalogPanIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_infrastructure_grpc_proto_calculator_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CatalogPanIdRequest.ProtoReflect.Descriptor instead.
+func (*CatalogPanIdRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_infrastructure_grpc_proto_calculator_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *CatalogPanIdRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type CatalogPanResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pan           *CatalogPanProto       `protobuf:"bytes,1,opt,name=pan,proto3" json:"pan,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CatalogPanResponse) Reset() {
+	*x = CatalogPanResponse{}
+	mi := &file_pkg_infrastructure_grpc_proto_calculator_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CatalogPanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CatalogPanResponse) ProtoMessage() {}
+
+func (x *CatalogPanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_infrastructure_grpc_proto_calculator_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CatalogPanResponse.ProtoReflect.Descriptor instead.
+func (*CatalogPanResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_infrastructure_grpc_proto_calculator_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CatalogPanResponse) GetPan() *CatalogPanProto {
+	if x != nil {
+		return x.Pan
+	}
+	return nil
+}
+
+type ListCatalogPansRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCatalogPansRequest) Reset() {
+	*x = ListCatalogPansRequest{}
+	mi := &file_pkg_infrastructure_grpc_proto_calculator_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCatalogPansRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCatalogPansRequest) ProtoMessage() {}
+
+func (x *ListCatalogPansRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_infrastructure_grpc_proto_calculator_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCatalogPansRequest.ProtoReflect.Descriptor instead.
+func (*ListCatalogPansRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_infrastructure_grpc_proto_calculator_proto_rawDescGZIP(), []int{27}
+}
+
+type ListCatalogPansResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pans          []*CatalogPanProto     `protobuf:"bytes,1,rep,name=pans,proto3" json:"pans,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCatalogPansResponse) Reset() {
+	*x = ListCatalogPansResponse{}
+	mi := &file_pkg_infrastructure_grpc_proto_calculator_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCatalogPansResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCatalogPansResponse) ProtoMessage() {}
+
+func (x *ListCatalogPansResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_infrastructure_grpc_proto_calculator_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCatalogPansResponse.ProtoReflect.Descriptor instead.
+func (*ListCatalogPansResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_infrastructure_grpc_proto_calculator_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListCatalogPansResponse) GetPans() []*CatalogPanProto {
 	if x != nil {
 		return x.Pans
 	}
 	return nil
 }
 
+type DeleteCatalogPanResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCatalogPanResponse) Reset() {
+	*x = DeleteCatalogPanResponse{}
+	mi := &file_pkg_infrastructure_grpc_proto_calculator_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCatalogPanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCatalogPanResponse) ProtoMessage() {}
+
+func (x *DeleteCatalogPanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_infrastructure_grpc_proto_calculator_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCatalogPanResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCatalogPanResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_infrastructure_grpc_proto_calculator_proto_rawDescGZIP(), []int{29}
+}
+
 var File_pkg_infrastructure_grpc_proto_calculator_proto protoreflect.FileDescriptor
 
 var file_pkg_infrastructure_grpc_proto_calculator_proto_rawDesc = string([]byte{
 	0x0a, 0x2e, 0x70, 0x6b, 0x67, 0x2f, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x73, 0x74, 0x72, 0x75, 0x63,
 	0x74, 0x75, 0x72, 0x65, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
 	0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x12, 0x0a, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x22, 0xac, 0x01, 0x0a,
+	0x12, 0x0a, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x22, 0xaf, 0x0b, 0x0a,
 	0x0d, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1f,
 	0x0a, 0x08, 0x64, 0x69, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
 	0x48, 0x00, 0x52, 0x08, 0x64, 0x69, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12,
@@ -312,41 +2236,416 @@ var file_pkg_infrastructure_grpc_proto_calculator_proto_rawDesc = string([]byte{
 	0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x48, 0x02, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68,
 	0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x04, 0x20,
 	0x01, 0x28, 0x05, 0x48, 0x03, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x88, 0x01, 0x01,
-	0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x64, 0x69, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x42, 0x07, 0x0a,
-	0x05, 0x5f, 0x65, 0x64, 0x67, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x77, 0x69, 0x64, 0x74, 0x68,
-	0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x22, 0x7f, 0x0a, 0x08, 0x50,
-	0x61, 0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x61, 0x70, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x61, 0x70, 0x65, 0x12, 0x35, 0x0a,
-	0x08, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x19, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x4d, 0x65, 0x61,
-	0x73, 0x75, 0x72, 0x65, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x08, 0x6d, 0x65, 0x61, 0x73,
-	0x75, 0x72, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x65, 0x61,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x61, 0x72, 0x65, 0x61, 0x22, 0x53, 0x0a, 0x09,
-	0x50, 0x61, 0x6e, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x28, 0x0a, 0x04, 0x70, 0x61, 0x6e,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c,
-	0x61, 0x74, 0x6f, 0x72, 0x2e, 0x50, 0x61, 0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x04, 0x70,
-	0x61, 0x6e, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x72, 0x65, 0x61,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x72, 0x65,
-	0x61, 0x22, 0x38, 0x0a, 0x0b, 0x50, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x21, 0x0a, 0x09, 0x6d, 0x61, 0x6a, 0x6f, 0x72, 0x41, 0x78, 0x69, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x05, 0x48, 0x04, 0x52, 0x09, 0x6d, 0x61, 0x6a, 0x6f, 0x72, 0x41, 0x78, 0x69, 0x73,
+	0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x6f, 0x72, 0x41, 0x78, 0x69, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x48, 0x05, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x6f, 0x72, 0x41,
+	0x78, 0x69, 0x73, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a, 0x0f, 0x64, 0x69, 0x61, 0x6d, 0x65, 0x74,
+	0x65, 0x72, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x48,
+	0x06, 0x52, 0x0f, 0x64, 0x69, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x44, 0x65, 0x63, 0x69, 0x6d,
+	0x61, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0b, 0x65, 0x64, 0x67, 0x65, 0x44, 0x65, 0x63,
+	0x69, 0x6d, 0x61, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x48, 0x07, 0x52, 0x0b, 0x65, 0x64,
+	0x67, 0x65, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x27, 0x0a, 0x0c,
+	0x77, 0x69, 0x64, 0x74, 0x68, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x01, 0x48, 0x08, 0x52, 0x0c, 0x77, 0x69, 0x64, 0x74, 0x68, 0x44, 0x65, 0x63, 0x69, 0x6d,
+	0x61, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x29, 0x0a, 0x0d, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x44,
+	0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x48, 0x09, 0x52, 0x0d,
+	0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x88, 0x01, 0x01,
+	0x12, 0x2f, 0x0a, 0x10, 0x6d, 0x61, 0x6a, 0x6f, 0x72, 0x41, 0x78, 0x69, 0x73, 0x44, 0x65, 0x63,
+	0x69, 0x6d, 0x61, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x48, 0x0a, 0x52, 0x10, 0x6d, 0x61,
+	0x6a, 0x6f, 0x72, 0x41, 0x78, 0x69, 0x73, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x88, 0x01,
+	0x01, 0x12, 0x2f, 0x0a, 0x10, 0x6d, 0x69, 0x6e, 0x6f, 0x72, 0x41, 0x78, 0x69, 0x73, 0x44, 0x65,
+	0x63, 0x69, 0x6d, 0x61, 0x6c, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x48, 0x0b, 0x52, 0x10, 0x6d,
+	0x69, 0x6e, 0x6f, 0x72, 0x41, 0x78, 0x69, 0x73, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x88,
+	0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x01, 0x48, 0x0c, 0x52, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a,
+	0x0b, 0x74, 0x6f, 0x70, 0x44, 0x69, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x01, 0x48, 0x0d, 0x52, 0x0b, 0x74, 0x6f, 0x70, 0x44, 0x69, 0x61, 0x6d, 0x65, 0x74, 0x65,
+	0x72, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x74, 0x6f, 0x70, 0x45, 0x64, 0x67, 0x65, 0x18,
+	0x0f, 0x20, 0x01, 0x28, 0x01, 0x48, 0x0e, 0x52, 0x07, 0x74, 0x6f, 0x70, 0x45, 0x64, 0x67, 0x65,
+	0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x57, 0x69, 0x64, 0x74, 0x68, 0x18,
+	0x10, 0x20, 0x01, 0x28, 0x01, 0x48, 0x0f, 0x52, 0x08, 0x74, 0x6f, 0x70, 0x57, 0x69, 0x64, 0x74,
+	0x68, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x74, 0x6f, 0x70, 0x4c, 0x65, 0x6e, 0x67, 0x74,
+	0x68, 0x18, 0x11, 0x20, 0x01, 0x28, 0x01, 0x48, 0x10, 0x52, 0x09, 0x74, 0x6f, 0x70, 0x4c, 0x65,
+	0x6e, 0x67, 0x74, 0x68, 0x88, 0x01, 0x01, 0x12, 0x27, 0x0a, 0x0c, 0x63, 0x6f, 0x72, 0x6e, 0x65,
+	0x72, 0x52, 0x61, 0x64, 0x69, 0x75, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28, 0x01, 0x48, 0x11, 0x52,
+	0x0c, 0x63, 0x6f, 0x72, 0x6e, 0x65, 0x72, 0x52, 0x61, 0x64, 0x69, 0x75, 0x73, 0x88, 0x01, 0x01,
+	0x12, 0x29, 0x0a, 0x0d, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x44, 0x69, 0x61, 0x6d, 0x65, 0x74, 0x65,
+	0x72, 0x18, 0x13, 0x20, 0x01, 0x28, 0x01, 0x48, 0x12, 0x52, 0x0d, 0x6f, 0x75, 0x74, 0x65, 0x72,
+	0x44, 0x69, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x29, 0x0a, 0x0d, 0x69,
+	0x6e, 0x6e, 0x65, 0x72, 0x44, 0x69, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x18, 0x14, 0x20, 0x01,
+	0x28, 0x01, 0x48, 0x13, 0x52, 0x0d, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x44, 0x69, 0x61, 0x6d, 0x65,
+	0x74, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x32, 0x0a, 0x08, 0x76, 0x65, 0x72, 0x74, 0x69, 0x63,
+	0x65, 0x73, 0x18, 0x15, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75,
+	0x6c, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x52, 0x08, 0x76, 0x65, 0x72, 0x74, 0x69, 0x63, 0x65, 0x73, 0x12, 0x19, 0x0a, 0x05, 0x73, 0x69,
+	0x64, 0x65, 0x73, 0x18, 0x16, 0x20, 0x01, 0x28, 0x05, 0x48, 0x14, 0x52, 0x05, 0x73, 0x69, 0x64,
+	0x65, 0x73, 0x88, 0x01, 0x01, 0x12, 0x27, 0x0a, 0x0c, 0x63, 0x69, 0x72, 0x63, 0x75, 0x6d, 0x72,
+	0x61, 0x64, 0x69, 0x75, 0x73, 0x18, 0x17, 0x20, 0x01, 0x28, 0x01, 0x48, 0x15, 0x52, 0x0c, 0x63,
+	0x69, 0x72, 0x63, 0x75, 0x6d, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x88, 0x01, 0x01, 0x12, 0x19,
+	0x0a, 0x05, 0x73, 0x69, 0x64, 0x65, 0x41, 0x18, 0x18, 0x20, 0x01, 0x28, 0x01, 0x48, 0x16, 0x52,
+	0x05, 0x73, 0x69, 0x64, 0x65, 0x41, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x73, 0x69, 0x64,
+	0x65, 0x42, 0x18, 0x19, 0x20, 0x01, 0x28, 0x01, 0x48, 0x17, 0x52, 0x05, 0x73, 0x69, 0x64, 0x65,
+	0x42, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x73, 0x69, 0x64, 0x65, 0x43, 0x18, 0x1a, 0x20,
+	0x01, 0x28, 0x01, 0x48, 0x18, 0x52, 0x05, 0x73, 0x69, 0x64, 0x65, 0x43, 0x88, 0x01, 0x01, 0x12,
+	0x12, 0x0a, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75,
+	0x6e, 0x69, 0x74, 0x12, 0x29, 0x0a, 0x0d, 0x77, 0x61, 0x6c, 0x6c, 0x54, 0x68, 0x69, 0x63, 0x6b,
+	0x6e, 0x65, 0x73, 0x73, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x01, 0x48, 0x19, 0x52, 0x0d, 0x77, 0x61,
+	0x6c, 0x6c, 0x54, 0x68, 0x69, 0x63, 0x6b, 0x6e, 0x65, 0x73, 0x73, 0x88, 0x01, 0x01, 0x12, 0x25,
+	0x0a, 0x0b, 0x63, 0x72, 0x75, 0x73, 0x74, 0x42, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x1d, 0x20,
+	0x01, 0x28, 0x01, 0x48, 0x1a, 0x52, 0x0b, 0x63, 0x72, 0x75, 0x73, 0x74, 0x42, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x64, 0x69, 0x61, 0x6d, 0x65, 0x74,
+	0x65, 0x72, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x65, 0x64, 0x67, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x5f,
+	0x77, 0x69, 0x64, 0x74, 0x68, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68,
+	0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6d, 0x61, 0x6a, 0x6f, 0x72, 0x41, 0x78, 0x69, 0x73, 0x42, 0x0c,
+	0x0a, 0x0a, 0x5f, 0x6d, 0x69, 0x6e, 0x6f, 0x72, 0x41, 0x78, 0x69, 0x73, 0x42, 0x12, 0x0a, 0x10,
+	0x5f, 0x64, 0x69, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c,
+	0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x65, 0x64, 0x67, 0x65, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c,
+	0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x77, 0x69, 0x64, 0x74, 0x68, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61,
+	0x6c, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x44, 0x65, 0x63, 0x69,
+	0x6d, 0x61, 0x6c, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x6d, 0x61, 0x6a, 0x6f, 0x72, 0x41, 0x78, 0x69,
+	0x73, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x6d, 0x69, 0x6e,
+	0x6f, 0x72, 0x41, 0x78, 0x69, 0x73, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x42, 0x08, 0x0a,
+	0x06, 0x5f, 0x64, 0x65, 0x70, 0x74, 0x68, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x74, 0x6f, 0x70, 0x44,
+	0x69, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x74, 0x6f, 0x70, 0x45,
+	0x64, 0x67, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x74, 0x6f, 0x70, 0x57, 0x69, 0x64, 0x74, 0x68,
+	0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x74, 0x6f, 0x70, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x42, 0x0f,
+	0x0a, 0x0d, 0x5f, 0x63, 0x6f, 0x72, 0x6e, 0x65, 0x72, 0x52, 0x61, 0x64, 0x69, 0x75, 0x73, 0x42,
+	0x10, 0x0a, 0x0e, 0x5f, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x44, 0x69, 0x61, 0x6d, 0x65, 0x74, 0x65,
+	0x72, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x44, 0x69, 0x61, 0x6d, 0x65,
+	0x74, 0x65, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x73, 0x69, 0x64, 0x65, 0x73, 0x42, 0x0f, 0x0a,
+	0x0d, 0x5f, 0x63, 0x69, 0x72, 0x63, 0x75, 0x6d, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x42, 0x08,
+	0x0a, 0x06, 0x5f, 0x73, 0x69, 0x64, 0x65, 0x41, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x73, 0x69, 0x64,
+	0x65, 0x42, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x73, 0x69, 0x64, 0x65, 0x43, 0x42, 0x10, 0x0a, 0x0e,
+	0x5f, 0x77, 0x61, 0x6c, 0x6c, 0x54, 0x68, 0x69, 0x63, 0x6b, 0x6e, 0x65, 0x73, 0x73, 0x42, 0x0e,
+	0x0a, 0x0c, 0x5f, 0x63, 0x72, 0x75, 0x73, 0x74, 0x42, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x22, 0x28,
+	0x0a, 0x0a, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x0a, 0x01,
+	0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x01, 0x79, 0x22, 0x84, 0x05, 0x0a, 0x08, 0x50, 0x61, 0x6e,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x61, 0x70, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x61, 0x70, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x6d,
+	0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x4d, 0x65, 0x61, 0x73, 0x75,
+	0x72, 0x65, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x08, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72,
+	0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x65, 0x61, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x61, 0x72, 0x65, 0x61, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x6f,
+	0x75, 0x67, 0x68, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0b, 0x64, 0x6f, 0x75, 0x67, 0x68, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x73, 0x69, 0x64, 0x65, 0x41, 0x72, 0x65, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08,
+	0x73, 0x69, 0x64, 0x65, 0x41, 0x72, 0x65, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x08, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x1a, 0x0a, 0x08,
+	0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x69, 0x6e, 0x65,
+	0x41, 0x72, 0x65, 0x61, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6c, 0x69, 0x6e, 0x65,
+	0x41, 0x72, 0x65, 0x61, 0x12, 0x28, 0x0a, 0x0f, 0x6c, 0x69, 0x6e, 0x65, 0x44, 0x6f, 0x75, 0x67,
+	0x68, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x6c,
+	0x69, 0x6e, 0x65, 0x44, 0x6f, 0x75, 0x67, 0x68, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1c,
+	0x0a, 0x09, 0x63, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x49, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x63, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x61,
+	0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x50, 0x61, 0x6e, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1c, 0x0a,
+	0x09, 0x64, 0x6f, 0x75, 0x67, 0x68, 0x41, 0x72, 0x65, 0x61, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x09, 0x64, 0x6f, 0x75, 0x67, 0x68, 0x41, 0x72, 0x65, 0x61, 0x12, 0x20, 0x0a, 0x0b, 0x74,
+	0x6f, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x41, 0x72, 0x65, 0x61, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0b, 0x74, 0x6f, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x41, 0x72, 0x65, 0x61, 0x12, 0x3e, 0x0a,
+	0x0b, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x10, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x2e,
+	0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x52, 0x0b, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1c, 0x0a,
+	0x09, 0x62, 0x61, 0x6c, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x09, 0x62, 0x61, 0x6c, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x62,
+	0x61, 0x6c, 0x6c, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x12, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0a, 0x62, 0x61, 0x6c, 0x6c, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x64,
+	0x6f, 0x75, 0x67, 0x68, 0x4f, 0x6e, 0x57, 0x61, 0x6c, 0x6c, 0x73, 0x18, 0x13, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0c, 0x64, 0x6f, 0x75, 0x67, 0x68, 0x4f, 0x6e, 0x57, 0x61, 0x6c, 0x6c, 0x73, 0x22,
+	0x55, 0x0a, 0x0d, 0x50, 0x61, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0xb0, 0x05, 0x0a, 0x09, 0x50, 0x61, 0x6e, 0x73, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x28, 0x0a, 0x04, 0x70, 0x61, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x2e,
+	0x50, 0x61, 0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x04, 0x70, 0x61, 0x6e, 0x73, 0x12, 0x1c,
+	0x0a, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x72, 0x65, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x72, 0x65, 0x61, 0x12, 0x2a, 0x0a, 0x10,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x44, 0x6f, 0x75, 0x67, 0x68, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x44, 0x6f, 0x75,
+	0x67, 0x68, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x2d, 0x0a, 0x0f, 0x74, 0x68, 0x69, 0x63,
+	0x6b, 0x6e, 0x65, 0x73, 0x73, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x01, 0x48, 0x00, 0x52, 0x0f, 0x74, 0x68, 0x69, 0x63, 0x6b, 0x6e, 0x65, 0x73, 0x73, 0x46, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x0a, 0x72, 0x69, 0x73, 0x65, 0x46,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x48, 0x01, 0x52, 0x0a, 0x72,
+	0x69, 0x73, 0x65, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x3e, 0x0a, 0x0b,
+	0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x49,
+	0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52,
+	0x0b, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x3c, 0x0a, 0x0a,
+	0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x49, 0x6e,
+	0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x0a,
+	0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x3c, 0x0a, 0x0a, 0x66, 0x69,
+	0x6e, 0x61, 0x6c, 0x44, 0x6f, 0x75, 0x67, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x49, 0x6e, 0x67, 0x72,
+	0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x0a, 0x66, 0x69,
+	0x6e, 0x61, 0x6c, 0x44, 0x6f, 0x75, 0x67, 0x68, 0x12, 0x33, 0x0a, 0x06, 0x66, 0x6c, 0x6f, 0x75,
+	0x72, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75,
+	0x6c, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x42, 0x6c, 0x65, 0x6e, 0x64, 0x46, 0x6c, 0x6f, 0x75, 0x72,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x06, 0x66, 0x6c, 0x6f, 0x75, 0x72, 0x73, 0x12, 0x25, 0x0a,
+	0x0b, 0x77, 0x61, 0x73, 0x74, 0x65, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x01, 0x48, 0x02, 0x52, 0x0b, 0x77, 0x61, 0x73, 0x74, 0x65, 0x46, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x88, 0x01, 0x01, 0x12, 0x2b, 0x0a, 0x0e, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x50, 0x72, 0x65,
+	0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x48, 0x03, 0x52, 0x0e,
+	0x73, 0x63, 0x61, 0x6c, 0x65, 0x50, 0x72, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x88, 0x01,
+	0x01, 0x12, 0x28, 0x0a, 0x0f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x61, 0x6c, 0x6c, 0x57, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x42, 0x61, 0x6c, 0x6c, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x6d,
+	0x69, 0x78, 0x44, 0x6f, 0x75, 0x67, 0x68, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0e, 0x6d, 0x69, 0x78, 0x44, 0x6f, 0x75, 0x67, 0x68, 0x57, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x74, 0x68, 0x69, 0x63, 0x6b, 0x6e, 0x65, 0x73,
+	0x73, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x72, 0x69, 0x73, 0x65,
+	0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x77, 0x61, 0x73, 0x74, 0x65,
+	0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x73, 0x63, 0x61, 0x6c, 0x65,
+	0x50, 0x72, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xe8, 0x01, 0x0a, 0x0c, 0x46, 0x6f,
+	0x72, 0x6d, 0x75, 0x6c, 0x61, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x21, 0x0a, 0x09, 0x68, 0x79,
+	0x64, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52,
+	0x09, 0x68, 0x79, 0x64, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a,
+	0x04, 0x73, 0x61, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x48, 0x01, 0x52, 0x04, 0x73,
+	0x61, 0x6c, 0x74, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x79, 0x65, 0x61, 0x73, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x01, 0x48, 0x02, 0x52, 0x05, 0x79, 0x65, 0x61, 0x73, 0x74, 0x88, 0x01,
+	0x01, 0x12, 0x15, 0x0a, 0x03, 0x6f, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x48, 0x03,
+	0x52, 0x03, 0x6f, 0x69, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x73, 0x75, 0x67, 0x61,
+	0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x48, 0x04, 0x52, 0x05, 0x73, 0x75, 0x67, 0x61, 0x72,
+	0x88, 0x01, 0x01, 0x12, 0x1c, 0x0a, 0x09, 0x79, 0x65, 0x61, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x79, 0x65, 0x61, 0x73, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x68, 0x79, 0x64, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42,
+	0x07, 0x0a, 0x05, 0x5f, 0x73, 0x61, 0x6c, 0x74, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x79, 0x65, 0x61,
+	0x73, 0x74, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x6f, 0x69, 0x6c, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x73,
+	0x75, 0x67, 0x61, 0x72, 0x22, 0x69, 0x0a, 0x11, 0x46, 0x65, 0x72, 0x6d, 0x65, 0x6e, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x68, 0x6f, 0x75,
+	0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x68, 0x6f, 0x75, 0x72, 0x73, 0x12,
+	0x20, 0x0a, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x79, 0x65, 0x61, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x79, 0x65, 0x61, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x22,
+	0x90, 0x01, 0x0a, 0x10, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x6f, 0x75, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x05, 0x66, 0x6c, 0x6f, 0x75, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x61,
+	0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x77, 0x61, 0x74, 0x65, 0x72,
+	0x12, 0x12, 0x0a, 0x04, 0x73, 0x61, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04,
+	0x73, 0x61, 0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x79, 0x65, 0x61, 0x73, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x05, 0x79, 0x65, 0x61, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6f, 0x69,
+	0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x6f, 0x69, 0x6c, 0x12, 0x14, 0x0a, 0x05,
+	0x73, 0x75, 0x67, 0x61, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x75, 0x67,
+	0x61, 0x72, 0x22, 0xfa, 0x02, 0x0a, 0x0b, 0x50, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x29, 0x0a, 0x04, 0x70, 0x61, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x50, 0x61,
+	0x6e, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x04, 0x70, 0x61, 0x6e, 0x73, 0x12, 0x12, 0x0a,
+	0x04, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x6e, 0x69,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x74, 0x72, 0x69, 0x63, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x74, 0x72,
+	0x69, 0x63, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x66, 0x6f, 0x72,
+	0x6d, 0x75, 0x6c, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x61, 0x6c,
+	0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x52, 0x07, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x12, 0x41, 0x0a,
+	0x0c, 0x66, 0x65, 0x72, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72,
+	0x2e, 0x46, 0x65, 0x72, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x52, 0x0c, 0x66, 0x65, 0x72, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x3b, 0x0a, 0x0a, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f,
+	0x72, 0x2e, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x30, 0x0a,
+	0x05, 0x62, 0x6c, 0x65, 0x6e, 0x64, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63,
+	0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x42, 0x6c, 0x65, 0x6e, 0x64, 0x50,
+	0x61, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x05, 0x62, 0x6c, 0x65, 0x6e, 0x64, 0x22,
+	0x9e, 0x02, 0x0a, 0x0c, 0x50, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
 	0x12, 0x29, 0x0a, 0x04, 0x70, 0x61, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
 	0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x50, 0x61, 0x6e, 0x73,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x04, 0x70, 0x61, 0x6e, 0x73, 0x22, 0x39, 0x0a, 0x0c, 0x50,
-	0x61, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x04, 0x70,
-	0x61, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x61, 0x6c, 0x63,
-	0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x50, 0x61, 0x6e, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x52, 0x04, 0x70, 0x61, 0x6e, 0x73, 0x32, 0x60, 0x0a, 0x0f, 0x44, 0x6f, 0x75, 0x67, 0x68, 0x43,
-	0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x4d, 0x0a, 0x16, 0x54, 0x6f, 0x74,
-	0x61, 0x6c, 0x44, 0x6f, 0x75, 0x67, 0x68, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x42, 0x79, 0x50,
-	0x61, 0x6e, 0x73, 0x12, 0x17, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72,
-	0x2e, 0x50, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x63,
-	0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x50, 0x61, 0x6e, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x49, 0x5a, 0x47, 0x67, 0x69, 0x74, 0x68,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x04, 0x70, 0x61, 0x6e, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x75,
+	0x6e, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x12,
+	0x2c, 0x0a, 0x05, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x53, 0x74, 0x79, 0x6c,
+	0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x05, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x12, 0x32, 0x0a,
+	0x07, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x46, 0x6f, 0x72, 0x6d,
+	0x75, 0x6c, 0x61, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x07, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c,
+	0x61, 0x12, 0x3b, 0x0a, 0x0a, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x6d, 0x65, 0x6e, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74,
+	0x6f, 0x72, 0x2e, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x30,
+	0x0a, 0x13, 0x68, 0x79, 0x64, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x6a, 0x75, 0x73,
+	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x13, 0x68, 0x79, 0x64,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74,
+	0x22, 0x98, 0x01, 0x0a, 0x0f, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x6d, 0x65, 0x6e, 0x74, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x6c, 0x6f, 0x75,
+	0x72, 0x53, 0x68, 0x61, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x66, 0x6c,
+	0x6f, 0x75, 0x72, 0x53, 0x68, 0x61, 0x72, 0x65, 0x12, 0x21, 0x0a, 0x09, 0x68, 0x79, 0x64, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x09, 0x68,
+	0x79, 0x64, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x0b, 0x69,
+	0x6e, 0x6f, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0b, 0x69, 0x6e, 0x6f, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0c, 0x0a,
+	0x0a, 0x5f, 0x68, 0x79, 0x64, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xd6, 0x01, 0x0a, 0x0a,
+	0x53, 0x74, 0x79, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20,
+	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x28, 0x0a, 0x0f, 0x74, 0x68, 0x69, 0x63, 0x6b, 0x6e, 0x65, 0x73, 0x73, 0x46, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x74, 0x68, 0x69, 0x63, 0x6b,
+	0x6e, 0x65, 0x73, 0x73, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x68, 0x79,
+	0x64, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x68,
+	0x79, 0x64, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x61, 0x6c, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x73, 0x61, 0x6c, 0x74, 0x12, 0x10, 0x0a, 0x03,
+	0x6f, 0x69, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x6f, 0x69, 0x6c, 0x12, 0x14,
+	0x0a, 0x05, 0x73, 0x75, 0x67, 0x61, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73,
+	0x75, 0x67, 0x61, 0x72, 0x22, 0x13, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x79, 0x6c,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x44, 0x0a, 0x12, 0x4c, 0x69, 0x73,
+	0x74, 0x53, 0x74, 0x79, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2e, 0x0a, 0x06, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x53, 0x74, 0x79,
+	0x6c, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x06, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x73, 0x22,
+	0x50, 0x0a, 0x0a, 0x46, 0x6c, 0x6f, 0x75, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x62, 0x73, 0x6f, 0x72, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x61, 0x62, 0x73, 0x6f, 0x72, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x13, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6c, 0x6f, 0x75, 0x72, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x44, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6c,
+	0x6f, 0x75, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x06,
+	0x66, 0x6c, 0x6f, 0x75, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63,
+	0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x46, 0x6c, 0x6f, 0x75, 0x72, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x52, 0x06, 0x66, 0x6c, 0x6f, 0x75, 0x72, 0x73, 0x22, 0x3c, 0x0a, 0x0e,
+	0x42, 0x6c, 0x65, 0x6e, 0x64, 0x50, 0x61, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x14,
+	0x0a, 0x05, 0x66, 0x6c, 0x6f, 0x75, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66,
+	0x6c, 0x6f, 0x75, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x61, 0x72, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x68, 0x61, 0x72, 0x65, 0x22, 0x6d, 0x0a, 0x0f, 0x42, 0x6c,
+	0x65, 0x6e, 0x64, 0x46, 0x6c, 0x6f, 0x75, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x2c, 0x0a,
+	0x05, 0x66, 0x6c, 0x6f, 0x75, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63,
+	0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x46, 0x6c, 0x6f, 0x75, 0x72, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x52, 0x05, 0x66, 0x6c, 0x6f, 0x75, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x68, 0x61, 0x72,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x27, 0x0a, 0x11, 0x4c, 0x69, 0x73,
+	0x74, 0x53, 0x68, 0x61, 0x70, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x6e,
+	0x69, 0x74, 0x22, 0xc2, 0x01, 0x0a, 0x10, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x53, 0x70,
+	0x65, 0x63, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72,
+	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72,
+	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6d,
+	0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12, 0x10, 0x0a,
+	0x03, 0x6d, 0x61, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x12,
+	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x57, 0x69,
+	0x74, 0x68, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69,
+	0x63, 0x74, 0x73, 0x57, 0x69, 0x74, 0x68, 0x22, 0x96, 0x01, 0x0a, 0x0a, 0x53, 0x68, 0x61, 0x70,
+	0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x6c,
+	0x69, 0x61, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x61, 0x6c, 0x69,
+	0x61, 0x73, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x08, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72,
+	0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75,
+	0x6c, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x53, 0x70, 0x65,
+	0x63, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x08, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x73,
+	0x22, 0x58, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x68, 0x61, 0x70, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x70, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61,
+	0x74, 0x6f, 0x72, 0x2e, 0x53, 0x68, 0x61, 0x70, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x06,
+	0x73, 0x68, 0x61, 0x70, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x22, 0x90, 0x01, 0x0a, 0x0f, 0x43,
+	0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x50, 0x61, 0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x73, 0x68, 0x61, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73,
+	0x68, 0x61, 0x70, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61,
+	0x74, 0x6f, 0x72, 0x2e, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x73, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x52, 0x08, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x42, 0x0a,
+	0x11, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x50, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x2d, 0x0a, 0x03, 0x70, 0x61, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x43, 0x61, 0x74,
+	0x61, 0x6c, 0x6f, 0x67, 0x50, 0x61, 0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x03, 0x70, 0x61,
+	0x6e, 0x22, 0x25, 0x0a, 0x13, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x50, 0x61, 0x6e, 0x49,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x43, 0x0a, 0x12, 0x43, 0x61, 0x74, 0x61,
+	0x6c, 0x6f, 0x67, 0x50, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d,
+	0x0a, 0x03, 0x70, 0x61, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x61,
+	0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67,
+	0x50, 0x61, 0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x03, 0x70, 0x61, 0x6e, 0x22, 0x18, 0x0a,
+	0x16, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x50, 0x61, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4a, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x43,
+	0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x50, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2f, 0x0a, 0x04, 0x70, 0x61, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x43, 0x61,
+	0x74, 0x61, 0x6c, 0x6f, 0x67, 0x50, 0x61, 0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x04, 0x70,
+	0x61, 0x6e, 0x73, 0x22, 0x1a, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x61, 0x74,
+	0x61, 0x6c, 0x6f, 0x67, 0x50, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32,
+	0x86, 0x06, 0x0a, 0x0f, 0x44, 0x6f, 0x75, 0x67, 0x68, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61,
+	0x74, 0x6f, 0x72, 0x12, 0x4d, 0x0a, 0x16, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x44, 0x6f, 0x75, 0x67,
+	0x68, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x42, 0x79, 0x50, 0x61, 0x6e, 0x73, 0x12, 0x17, 0x2e,
+	0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x50, 0x61, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61,
+	0x74, 0x6f, 0x72, 0x2e, 0x50, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x4d, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x68, 0x61, 0x70, 0x65, 0x73,
+	0x12, 0x1d, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x53, 0x68, 0x61, 0x70, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1e, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x53, 0x68, 0x61, 0x70, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x4d, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x79, 0x6c, 0x65, 0x73, 0x12,
+	0x1d, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x53, 0x74, 0x79, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e,
+	0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x53, 0x74, 0x79, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x4d, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6c, 0x6f, 0x75, 0x72, 0x73, 0x12, 0x1d,
+	0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x46, 0x6c, 0x6f, 0x75, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e,
+	0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46,
+	0x6c, 0x6f, 0x75, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x53, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67,
+	0x50, 0x61, 0x6e, 0x12, 0x1d, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72,
+	0x2e, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x50, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x2e,
+	0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x50, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x43, 0x61, 0x74, 0x61, 0x6c,
+	0x6f, 0x67, 0x50, 0x61, 0x6e, 0x12, 0x1f, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74,
+	0x6f, 0x72, 0x2e, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x50, 0x61, 0x6e, 0x49, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61,
+	0x74, 0x6f, 0x72, 0x2e, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x50, 0x61, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74,
+	0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x50, 0x61, 0x6e, 0x73, 0x12, 0x22, 0x2e, 0x63, 0x61,
+	0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x74,
+	0x61, 0x6c, 0x6f, 0x67, 0x50, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x23, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x50, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x50, 0x61, 0x6e, 0x12, 0x1d, 0x2e, 0x63, 0x61, 0x6c,
+	0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x50,
+	0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x63, 0x61, 0x6c, 0x63,
+	0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x50, 0x61,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x10, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x50, 0x61, 0x6e, 0x12,
+	0x1f, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x43, 0x61, 0x74,
+	0x61, 0x6c, 0x6f, 0x67, 0x50, 0x61, 0x6e, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x24, 0x2e, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x50, 0x61, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x3f, 0x5a, 0x3d, 0x67, 0x69, 0x74, 0x68,
 	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x66, 0x69, 0x6f, 0x72, 0x65, 0x74, 0x74, 0x69,
-	0x2f, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x2d, 0x62, 0x61, 0x6c,
-	0x61, 0x6e, 0x63, 0x65, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x73,
-	0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x2f, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f,
+	0x69, 0x6e, 0x66, 0x72, 0x61, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2f, 0x67,
+	0x72, 0x70, 0x63, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 })
 
 var (
@@ -361,26 +2660,90 @@ func file_pkg_infrastructure_grpc_proto_calculator_proto_rawDescGZIP() []byte {
 	return file_pkg_infrastructure_grpc_proto_calculator_proto_rawDescData
 }
 
-var file_pkg_infrastructure_grpc_proto_calculator_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_pkg_infrastructure_grpc_proto_calculator_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
 var file_pkg_infrastructure_grpc_proto_calculator_proto_goTypes = []any{
-	(*MeasuresProto)(nil), // 0: calculator.MeasuresProto
-	(*PanProto)(nil),      // 1: calculator.PanProto
-	(*PansProto)(nil),     // 2: calculator.PansProto
-	(*PansRequest)(nil),   // 3: calculator.PansRequest
-	(*PansResponse)(nil),  // 4: calculator.PansResponse
+	(*MeasuresProto)(nil),            // 0: calculator.MeasuresProto
+	(*PointProto)(nil),               // 1: calculator.PointProto
+	(*PanProto)(nil),                 // 2: calculator.PanProto
+	(*PanErrorProto)(nil),            // 3: calculator.PanErrorProto
+	(*PansProto)(nil),                // 4: calculator.PansProto
+	(*FormulaProto)(nil),             // 5: calculator.FormulaProto
+	(*FermentationProto)(nil),        // 6: calculator.FermentationProto
+	(*IngredientsProto)(nil),         // 7: calculator.IngredientsProto
+	(*PansRequest)(nil),              // 8: calculator.PansRequest
+	(*PansResponse)(nil),             // 9: calculator.PansResponse
+	(*PrefermentProto)(nil),          // 10: calculator.PrefermentProto
+	(*StyleProto)(nil),               // 11: calculator.StyleProto
+	(*ListStylesRequest)(nil),        // 12: calculator.ListStylesRequest
+	(*ListStylesResponse)(nil),       // 13: calculator.ListStylesResponse
+	(*FlourProto)(nil),               // 14: calculator.FlourProto
+	(*ListFloursRequest)(nil),        // 15: calculator.ListFloursRequest
+	(*ListFloursResponse)(nil),       // 16: calculator.ListFloursResponse
+	(*BlendPartProto)(nil),           // 17: calculator.BlendPartProto
+	(*BlendFlourProto)(nil),          // 18: calculator.BlendFlourProto
+	(*ListShapesRequest)(nil),        // 19: calculator.ListShapesRequest
+	(*MeasureSpecProto)(nil),         // 20: calculator.MeasureSpecProto
+	(*ShapeProto)(nil),               // 21: calculator.ShapeProto
+	(*ListShapesResponse)(nil),       // 22: calculator.ListShapesResponse
+	(*CatalogPanProto)(nil),          // 23: calculator.CatalogPanProto
+	(*CatalogPanRequest)(nil),        // 24: calculator.CatalogPanRequest
+	(*CatalogPanIdRequest)(nil),      // 25: calculator.CatalogPanIdRequest
+	(*CatalogPanResponse)(nil),       // 26: calculator.CatalogPanResponse
+	(*ListCatalogPansRequest)(nil),   // 27: calculator.ListCatalogPansRequest
+	(*ListCatalogPansResponse)(nil),  // 28: calculator.ListCatalogPansResponse
+	(*DeleteCatalogPanResponse)(nil), // 29: calculator.DeleteCatalogPanResponse
 }
 var file_pkg_infrastructure_grpc_proto_calculator_proto_depIdxs = []int32{
-	0, // 0: calculator.PanProto.measures:type_name -> calculator.MeasuresProto
-	1, // 1: calculator.PansProto.pans:type_name -> calculator.PanProto
-	2, // 2: calculator.PansRequest.pans:type_name -> calculator.PansProto
-	2, // 3: calculator.PansResponse.pans:type_name -> calculator.PansProto
-	3, // 4: calculator.DoughCalculator.TotalDoughWeightByPans:input_type -> calculator.PansRequest
-	4, // 5: calculator.DoughCalculator.TotalDoughWeightByPans:output_type -> calculator.PansResponse
-	5, // [5:6] is the sub-list for method output_type
-	4, // [4:5] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	1,  // 0: calculator.MeasuresProto.vertices:type_name -> calculator.PointProto
+	0,  // 1: calculator.PanProto.measures:type_name -> calculator.MeasuresProto
+	3,  // 2: calculator.PanProto.error:type_name -> calculator.PanErrorProto
+	7,  // 3: calculator.PanProto.ingredients:type_name -> calculator.IngredientsProto
+	2,  // 4: calculator.PansProto.pans:type_name -> calculator.PanProto
+	7,  // 5: calculator.PansProto.ingredients:type_name -> calculator.IngredientsProto
+	7,  // 6: calculator.PansProto.preferment:type_name -> calculator.IngredientsProto
+	7,  // 7: calculator.PansProto.finalDough:type_name -> calculator.IngredientsProto
+	18, // 8: calculator.PansProto.flours:type_name -> calculator.BlendFlourProto
+	4,  // 9: calculator.PansRequest.pans:type_name -> calculator.PansProto
+	5,  // 10: calculator.PansRequest.formula:type_name -> calculator.FormulaProto
+	6,  // 11: calculator.PansRequest.fermentation:type_name -> calculator.FermentationProto
+	10, // 12: calculator.PansRequest.preferment:type_name -> calculator.PrefermentProto
+	17, // 13: calculator.PansRequest.blend:type_name -> calculator.BlendPartProto
+	4,  // 14: calculator.PansResponse.pans:type_name -> calculator.PansProto
+	11, // 15: calculator.PansResponse.style:type_name -> calculator.StyleProto
+	5,  // 16: calculator.PansResponse.formula:type_name -> calculator.FormulaProto
+	10, // 17: calculator.PansResponse.preferment:type_name -> calculator.PrefermentProto
+	11, // 18: calculator.ListStylesResponse.styles:type_name -> calculator.StyleProto
+	14, // 19: calculator.ListFloursResponse.flours:type_name -> calculator.FlourProto
+	14, // 20: calculator.BlendFlourProto.flour:type_name -> calculator.FlourProto
+	20, // 21: calculator.ShapeProto.measures:type_name -> calculator.MeasureSpecProto
+	21, // 22: calculator.ListShapesResponse.shapes:type_name -> calculator.ShapeProto
+	0,  // 23: calculator.CatalogPanProto.measures:type_name -> calculator.MeasuresProto
+	23, // 24: calculator.CatalogPanRequest.pan:type_name -> calculator.CatalogPanProto
+	23, // 25: calculator.CatalogPanResponse.pan:type_name -> calculator.CatalogPanProto
+	23, // 26: calculator.ListCatalogPansResponse.pans:type_name -> calculator.CatalogPanProto
+	8,  // 27: calculator.DoughCalculator.TotalDoughWeightByPans:input_type -> calculator.PansRequest
+	19, // 28: calculator.DoughCalculator.ListShapes:input_type -> calculator.ListShapesRequest
+	12, // 29: calculator.DoughCalculator.ListStyles:input_type -> calculator.ListStylesRequest
+	15, // 30: calculator.DoughCalculator.ListFlours:input_type -> calculator.ListFloursRequest
+	24, // 31: calculator.DoughCalculator.CreateCatalogPan:input_type -> calculator.CatalogPanRequest
+	25, // 32: calculator.DoughCalculator.GetCatalogPan:input_type -> calculator.CatalogPanIdRequest
+	27, // 33: calculator.DoughCalculator.ListCatalogPans:input_type -> calculator.ListCatalogPansRequest
+	24, // 34: calculator.DoughCalculator.UpdateCatalogPan:input_type -> calculator.CatalogPanRequest
+	25, // 35: calculator.DoughCalculator.DeleteCatalogPan:input_type -> calculator.CatalogPanIdRequest
+	9,  // 36: calculator.DoughCalculator.TotalDoughWeightByPans:output_type -> calculator.PansResponse
+	22, // 37: calculator.DoughCalculator.ListShapes:output_type -> calculator.ListShapesResponse
+	13, // 38: calculator.DoughCalculator.ListStyles:output_type -> calculator.ListStylesResponse
+	16, // 39: calculator.DoughCalculator.ListFlours:output_type -> calculator.ListFloursResponse
+	26, // 40: calculator.DoughCalculator.CreateCatalogPan:output_type -> calculator.CatalogPanResponse
+	26, // 41: calculator.DoughCalculator.GetCatalogPan:output_type -> calculator.CatalogPanResponse
+	28, // 42: calculator.DoughCalculator.ListCatalogPans:output_type -> calculator.ListCatalogPansResponse
+	26, // 43: calculator.DoughCalculator.UpdateCatalogPan:output_type -> calculator.CatalogPanResponse
+	29, // 44: calculator.DoughCalculator.DeleteCatalogPan:output_type -> calculator.DeleteCatalogPanResponse
+	36, // [36:45] is the sub-list for method output_type
+	27, // [27:36] is the sub-list for method input_type
+	27, // [27:27] is the sub-list for extension type_name
+	27, // [27:27] is the sub-list for extension extendee
+	0,  // [0:27] is the sub-list for field type_name
 }
 
 func init() { file_pkg_infrastructure_grpc_proto_calculator_proto_init() }
@@ -389,13 +2752,16 @@ func file_pkg_infrastructure_grpc_proto_calculator_proto_init() {
 		return
 	}
 	file_pkg_infrastructure_grpc_proto_calculator_proto_msgTypes[0].OneofWrappers = []any{}
+	file_pkg_infrastructure_grpc_proto_calculator_proto_msgTypes[4].OneofWrappers = []any{}
+	file_pkg_infrastructure_grpc_proto_calculator_proto_msgTypes[5].OneofWrappers = []any{}
+	file_pkg_infrastructure_grpc_proto_calculator_proto_msgTypes[10].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_pkg_infrastructure_grpc_proto_calculator_proto_rawDesc), len(file_pkg_infrastructure_grpc_proto_calculator_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   30,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -20,6 +20,14 @@ const _ = grpc.SupportPackageIsVersion9
 
 const (
 	DoughCalculator_TotalDoughWeightByPans_FullMethodName = "/calculator.DoughCalculator/TotalDoughWeightByPans"
+	DoughCalculator_ListShapes_FullMethodName             = "/calculator.DoughCalculator/ListShapes"
+	DoughCalculator_ListStyles_FullMethodName             = "/calculator.DoughCalculator/ListStyles"
+	DoughCalculator_ListFlours_FullMethodName             = "/calculator.DoughCalculator/ListFlours"
+	DoughCalculator_CreateCatalogPan_FullMethodName       = "/calculator.DoughCalculator/CreateCatalogPan"
+	DoughCalculator_GetCatalogPan_FullMethodName          = "/calculator.DoughCalculator/GetCatalogPan"
+	DoughCalculator_ListCatalogPans_FullMethodName        = "/calculator.DoughCalculator/ListCatalogPans"
+	DoughCalculator_UpdateCatalogPan_FullMethodName       = "/calculator.DoughCalculator/UpdateCatalogPan"
+	DoughCalculator_DeleteCatalogPan_FullMethodName       = "/calculator.DoughCalculator/DeleteCatalogPan"
 )
 
 // DoughCalculatorClient is the client API for DoughCalculator service.
@@ -27,6 +35,14 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type DoughCalculatorClient interface {
 	TotalDoughWeightByPans(ctx context.Context, in *PansRequest, opts ...grpc.CallOption) (*PansResponse, error)
+	ListShapes(ctx context.Context, in *ListShapesRequest, opts ...grpc.CallOption) (*ListShapesResponse, error)
+	ListStyles(ctx context.Context, in *ListStylesRequest, opts ...grpc.CallOption) (*ListStylesResponse, error)
+	ListFlours(ctx context.Context, in *ListFloursRequest, opts ...grpc.CallOption) (*ListFloursResponse, error)
+	CreateCatalogPan(ctx context.Context, in *CatalogPanRequest, opts ...grpc.CallOption) (*CatalogPanResponse, error)
+	GetCatalogPan(ctx context.Context, in *CatalogPanIdRequest, opts ...grpc.CallOption) (*CatalogPanResponse, error)
+	ListCatalogPans(ctx context.Context, in *ListCatalogPansRequest, opts ...grpc.CallOption) (*ListCatalogPansResponse, error)
+	UpdateCatalogPan(ctx context.Context, in *CatalogPanRequest, opts ...grpc.CallOption) (*CatalogPanResponse, error)
+	DeleteCatalogPan(ctx context.Context, in *CatalogPanIdRequest, opts ...grpc.CallOption) (*DeleteCatalogPanResponse, error)
 }
 
 type doughCalculatorClient struct {
@@ -47,11 +63,99 @@ func (c *doughCalculatorClient) TotalDoughWeightByPans(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *doughCalculatorClient) ListShapes(ctx context.Context, in *ListShapesRequest, opts ...grpc.CallOption) (*ListShapesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListShapesResponse)
+	err := c.cc.Invoke(ctx, DoughCalculator_ListShapes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doughCalculatorClient) ListStyles(ctx context.Context, in *ListStylesRequest, opts ...grpc.CallOption) (*ListStylesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListStylesResponse)
+	err := c.cc.Invoke(ctx, DoughCalculator_ListStyles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doughCalculatorClient) ListFlours(ctx context.Context, in *ListFloursRequest, opts ...grpc.CallOption) (*ListFloursResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFloursResponse)
+	err := c.cc.Invoke(ctx, DoughCalculator_ListFlours_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doughCalculatorClient) CreateCatalogPan(ctx context.Context, in *CatalogPanRequest, opts ...grpc.CallOption) (*CatalogPanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CatalogPanResponse)
+	err := c.cc.Invoke(ctx, DoughCalculator_CreateCatalogPan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doughCalculatorClient) GetCatalogPan(ctx context.Context, in *CatalogPanIdRequest, opts ...grpc.CallOption) (*CatalogPanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CatalogPanResponse)
+	err := c.cc.Invoke(ctx, DoughCalculator_GetCatalogPan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doughCalculatorClient) ListCatalogPans(ctx context.Context, in *ListCatalogPansRequest, opts ...grpc.CallOption) (*ListCatalogPansResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCatalogPansResponse)
+	err := c.cc.Invoke(ctx, DoughCalculator_ListCatalogPans_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doughCalculatorClient) UpdateCatalogPan(ctx context.Context, in *CatalogPanRequest, opts ...grpc.CallOption) (*CatalogPanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CatalogPanResponse)
+	err := c.cc.Invoke(ctx, DoughCalculator_UpdateCatalogPan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doughCalculatorClient) DeleteCatalogPan(ctx context.Context, in *CatalogPanIdRequest, opts ...grpc.CallOption) (*DeleteCatalogPanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCatalogPanResponse)
+	err := c.cc.Invoke(ctx, DoughCalculator_DeleteCatalogPan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DoughCalculatorServer is the server API for DoughCalculator service.
 // All implementations must embed UnimplementedDoughCalculatorServer
 // for forward compatibility.
 type DoughCalculatorServer interface {
 	TotalDoughWeightByPans(context.Context, *PansRequest) (*PansResponse, error)
+	ListShapes(context.Context, *ListShapesRequest) (*ListShapesResponse, error)
+	ListStyles(context.Context, *ListStylesRequest) (*ListStylesResponse, error)
+	ListFlours(context.Context, *ListFloursRequest) (*ListFloursResponse, error)
+	CreateCatalogPan(context.Context, *CatalogPanRequest) (*CatalogPanResponse, error)
+	GetCatalogPan(context.Context, *CatalogPanIdRequest) (*CatalogPanResponse, error)
+	ListCatalogPans(context.Context, *ListCatalogPansRequest) (*ListCatalogPansResponse, error)
+	UpdateCatalogPan(context.Context, *CatalogPanRequest) (*CatalogPanResponse, error)
+	DeleteCatalogPan(context.Context, *CatalogPanIdRequest) (*DeleteCatalogPanResponse, error)
 	mustEmbedUnimplementedDoughCalculatorServer()
 }
 
@@ -65,6 +169,30 @@ type UnimplementedDoughCalculatorServer struct{}
 func (UnimplementedDoughCalculatorServer) TotalDoughWeightByPans(context.Context, *PansRequest) (*PansResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TotalDoughWeightByPans not implemented")
 }
+func (UnimplementedDoughCalculatorServer) ListShapes(context.Context, *ListShapesRequest) (*ListShapesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListShapes not implemented")
+}
+func (UnimplementedDoughCalculatorServer) ListStyles(context.Context, *ListStylesRequest) (*ListStylesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStyles not implemented")
+}
+func (UnimplementedDoughCalculatorServer) ListFlours(context.Context, *ListFloursRequest) (*ListFloursResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFlours not implemented")
+}
+func (UnimplementedDoughCalculatorServer) CreateCatalogPan(context.Context, *CatalogPanRequest) (*CatalogPanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCatalogPan not implemented")
+}
+func (UnimplementedDoughCalculatorServer) GetCatalogPan(context.Context, *CatalogPanIdRequest) (*CatalogPanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCatalogPan not implemented")
+}
+func (UnimplementedDoughCalculatorServer) ListCatalogPans(context.Context, *ListCatalogPansRequest) (*ListCatalogPansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCatalogPans not implemented")
+}
+func (UnimplementedDoughCalculatorServer) UpdateCatalogPan(context.Context, *CatalogPanRequest) (*CatalogPanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCatalogPan not implemented")
+}
+func (UnimplementedDoughCalculatorServer) DeleteCatalogPan(context.Context, *CatalogPanIdRequest) (*DeleteCatalogPanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCatalogPan not implemented")
+}
 func (UnimplementedDoughCalculatorServer) mustEmbedUnimplementedDoughCalculatorServer() {}
 func (UnimplementedDoughCalculatorServer) testEmbeddedByValue()                         {}
 
@@ -104,6 +232,150 @@ func _DoughCalculator_TotalDoughWeightByPans_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DoughCalculator_ListShapes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListShapesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoughCalculatorServer).ListShapes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DoughCalculator_ListShapes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoughCalculatorServer).ListShapes(ctx, req.(*ListShapesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoughCalculator_ListStyles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStylesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoughCalculatorServer).ListStyles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DoughCalculator_ListStyles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoughCalculatorServer).ListStyles(ctx, req.(*ListStylesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoughCalculator_ListFlours_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFloursRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoughCalculatorServer).ListFlours(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DoughCalculator_ListFlours_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoughCalculatorServer).ListFlours(ctx, req.(*ListFloursRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoughCalculator_CreateCatalogPan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CatalogPanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoughCalculatorServer).CreateCatalogPan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DoughCalculator_CreateCatalogPan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoughCalculatorServer).CreateCatalogPan(ctx, req.(*CatalogPanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoughCalculator_GetCatalogPan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CatalogPanIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoughCalculatorServer).GetCatalogPan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DoughCalculator_GetCatalogPan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoughCalculatorServer).GetCatalogPan(ctx, req.(*CatalogPanIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoughCalculator_ListCatalogPans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCatalogPansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoughCalculatorServer).ListCatalogPans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DoughCalculator_ListCatalogPans_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoughCalculatorServer).ListCatalogPans(ctx, req.(*ListCatalogPansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoughCalculator_UpdateCatalogPan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CatalogPanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoughCalculatorServer).UpdateCatalogPan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DoughCalculator_UpdateCatalogPan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoughCalculatorServer).UpdateCatalogPan(ctx, req.(*CatalogPanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoughCalculator_DeleteCatalogPan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CatalogPanIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoughCalculatorServer).DeleteCatalogPan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DoughCalculator_DeleteCatalogPan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoughCalculatorServer).DeleteCatalogPan(ctx, req.(*CatalogPanIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DoughCalculator_ServiceDesc is the grpc.ServiceDesc for DoughCalculator service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -115,6 +387,38 @@ var DoughCalculator_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TotalDoughWeightByPans",
 			Handler:    _DoughCalculator_TotalDoughWeightByPans_Handler,
 		},
+		{
+			MethodName: "ListShapes",
+			Handler:    _DoughCalculator_ListShapes_Handler,
+		},
+		{
+			MethodName: "ListStyles",
+			Handler:    _DoughCalculator_ListStyles_Handler,
+		},
+		{
+			MethodName: "ListFlours",
+			Handler:    _DoughCalculator_ListFlours_Handler,
+		},
+		{
+			MethodName: "CreateCatalogPan",
+			Handler:    _DoughCalculator_CreateCatalogPan_Handler,
+		},
+		{
+			MethodName: "GetCatalogPan",
+			Handler:    _DoughCalculator_GetCatalogPan_Handler,
+		},
+		{
+			MethodName: "ListCatalogPans",
+			Handler:    _DoughCalculator_ListCatalogPans_Handler,
+		},
+		{
+			MethodName: "UpdateCatalogPan",
+			Handler:    _DoughCalculator_UpdateCatalogPan_Handler,
+		},
+		{
+			MethodName: "DeleteCatalogPan",
+			Handler:    _DoughCalculator_DeleteCatalogPan_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/infrastructure/grpc/proto/calculator.proto",
@@ -2,63 +2,279 @@ package grpc
 
 import (
 	"context"
+	"math"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/cfioretti/calculator/pkg/domain"
 	pb "github.com/cfioretti/calculator/pkg/infrastructure/grpc/proto/generated"
+	"github.com/cfioretti/calculator/pkg/shapes"
 )
 
 type CalculatorService interface {
 	TotalDoughWeightByPans(context.Context, domain.Pans) (*domain.Pans, error)
+	SupportedShapes(context.Context) []shapes.Shape
+	Styles(context.Context) []domain.Style
+	Flours(context.Context) []domain.Flour
 }
 
 type Server struct {
 	pb.UnimplementedDoughCalculatorServer
 	calculatorService CalculatorService
+	catalogService    CatalogService
+}
+
+type ServerOption func(*Server)
+
+// WithCatalogService enables the pan catalog RPCs.
+func WithCatalogService(catalogService CatalogService) ServerOption {
+	return func(s *Server) {
+		s.catalogService = catalogService
+	}
 }
 
-func NewServer(calculatorService CalculatorService) *Server {
-	return &Server{
+func NewServer(calculatorService CalculatorService, opts ...ServerOption) *Server {
+	s := &Server{
 		calculatorService: calculatorService,
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
 }
 
 func (s *Server) TotalDoughWeightByPans(ctx context.Context, req *pb.PansRequest) (*pb.PansResponse, error) {
-	domainPans := toDomainPans(req.Pans)
+	invalidPans, err := validatePansRequest(req, s.calculatorService.SupportedShapes(ctx), s.calculatorService.Styles(ctx), s.calculatorService.Flours(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	unit, err := domain.ParseUnit(req.Unit)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	domainPans, err := toDomainPans(req.Pans)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	domainPans.Unit = unit
+	domainPans.Partial = req.Partial
+	domainPans.Strict = req.Strict
+	domainPans.StyleID = req.Style
+	domainPans.FormulaOverrides = toDomainFormulaOverrides(req.Formula)
+	domainPans.Fermentation, err = toDomainFermentation(req.Fermentation)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	domainPans.Preferment, err = toDomainPreferment(req.Preferment)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	domainPans.Blend = toDomainBlend(req.Blend)
+
+	var positions []int
+	domainPans.Pans, positions = calculablePans(domainPans.Pans, invalidPans)
 
 	result, err := s.calculatorService.TotalDoughWeightByPans(ctx, domainPans)
 	if err != nil {
-		return nil, err
+		return nil, toStatus(err)
 	}
 
 	responseProto := toProtoMessage(result)
+	responseProto.Pans = restoreInvalidPans(req.Pans.GetPans(), responseProto.Pans, positions, invalidPans)
 
 	return &pb.PansResponse{
-		Pans: responseProto,
+		Pans:       responseProto,
+		Unit:       string(result.Unit),
+		Style:      toProtoStyle(result.Style),
+		Formula:    toProtoFormula(result.Formula),
+		Preferment: toProtoPreferment(result.Preferment),
+
+		HydrationAdjustment: result.HydrationAdjustment,
+	}, nil
+}
+
+// calculablePans leaves out the pans that failed validation in partial mode
+// and returns the request position of each pan left.
+func calculablePans(pans []domain.Pan, invalidPans map[int]*pb.PanErrorProto) ([]domain.Pan, []int) {
+	calculable := make([]domain.Pan, 0, len(pans))
+	positions := make([]int, 0, len(pans))
+	for i, pan := range pans {
+		if _, invalid := invalidPans[i]; invalid {
+			continue
+		}
+		calculable = append(calculable, pan)
+		positions = append(positions, i)
+	}
+	return calculable, positions
+}
+
+// restoreInvalidPans puts the pans that failed validation back in their
+// request position, so that response pans line up with request pans.
+func restoreInvalidPans(requested, calculated []*pb.PanProto, positions []int, invalidPans map[int]*pb.PanErrorProto) []*pb.PanProto {
+	if len(invalidPans) == 0 {
+		return calculated
+	}
+
+	pans := make([]*pb.PanProto, len(requested))
+	for i, position := range positions {
+		pans[position] = calculated[i]
+	}
+	for index, panError := range invalidPans {
+		pan := requested[index]
+		pans[index] = &pb.PanProto{
+			CatalogId:    pan.GetCatalogId(),
+			Shape:        pan.GetShape(),
+			Measures:     pan.GetMeasures(),
+			DoughOnWalls: pan.GetDoughOnWalls(),
+			Quantity:     pan.GetQuantity(),
+			BallCount:    pan.GetBallCount(),
+			Error:        panError,
+		}
+	}
+	return pans
+}
+
+func (s *Server) ListShapes(ctx context.Context, req *pb.ListShapesRequest) (*pb.ListShapesResponse, error) {
+	unit, err := domain.ParseUnit(req.Unit)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	supportedShapes := s.calculatorService.SupportedShapes(ctx)
+	shapeProtos := make([]*pb.ShapeProto, 0, len(supportedShapes))
+	for _, shape := range supportedShapes {
+		shapeProtos = append(shapeProtos, toProtoShape(shape, unit))
+	}
+
+	return &pb.ListShapesResponse{
+		Shapes: shapeProtos,
+		Unit:   string(unit),
 	}, nil
 }
 
-func toDomainPans(protoMessage *pb.PansProto) domain.Pans {
+func (s *Server) ListStyles(ctx context.Context, req *pb.ListStylesRequest) (*pb.ListStylesResponse, error) {
+	styles := s.calculatorService.Styles(ctx)
+	styleProtos := make([]*pb.StyleProto, 0, len(styles))
+	for _, style := range styles {
+		styleProtos = append(styleProtos, toProtoStyle(&style))
+	}
+	return &pb.ListStylesResponse{Styles: styleProtos}, nil
+}
+
+func (s *Server) ListFlours(ctx context.Context, req *pb.ListFloursRequest) (*pb.ListFloursResponse, error) {
+	flours := s.calculatorService.Flours(ctx)
+	flourProtos := make([]*pb.FlourProto, 0, len(flours))
+	for _, flour := range flours {
+		flourProtos = append(flourProtos, toProtoFlour(flour))
+	}
+	return &pb.ListFloursResponse{Flours: flourProtos}, nil
+}
+
+func toProtoFlour(flour domain.Flour) *pb.FlourProto {
+	return &pb.FlourProto{
+		Id:         flour.ID,
+		Name:       flour.Name,
+		Absorption: flour.Absorption,
+	}
+}
+
+func toDomainBlend(blend []*pb.BlendPartProto) []domain.BlendPart {
+	if len(blend) == 0 {
+		return nil
+	}
+	parts := make([]domain.BlendPart, 0, len(blend))
+	for _, part := range blend {
+		parts = append(parts, domain.BlendPart{FlourID: part.GetFlour(), Share: part.GetShare()})
+	}
+	return parts
+}
+
+func toProtoStyle(style *domain.Style) *pb.StyleProto {
+	if style == nil {
+		return nil
+	}
+	return &pb.StyleProto{
+		Id:              style.ID,
+		Name:            style.Name,
+		Description:     style.Description,
+		ThicknessFactor: style.ThicknessFactor,
+		Hydration:       style.Hydration,
+		Salt:            style.Salt,
+		Oil:             style.Oil,
+		Sugar:           style.Sugar,
+	}
+}
+
+func toProtoShape(shape shapes.Shape, unit domain.Unit) *pb.ShapeProto {
+	measures := make([]*pb.MeasureSpecProto, 0, len(shape.Measures))
+	for _, measure := range shape.Measures {
+		measure = measure.In(unit)
+		measureUnit := string(unit)
+		if measure.Kind == shapes.CountMeasure {
+			measureUnit = "count"
+		}
+		measures = append(measures, &pb.MeasureSpecProto{
+			Name:          measure.Name,
+			Required:      measure.Required,
+			Unit:          measureUnit,
+			Min:           measure.Min,
+			Max:           measure.Max,
+			Description:   measure.Description,
+			ConflictsWith: measure.ConflictsWith,
+		})
+	}
+
+	return &pb.ShapeProto{
+		Name:        shape.Name,
+		Aliases:     shape.Aliases,
+		Description: shape.Description,
+		Measures:    measures,
+	}
+}
+
+func toDomainPans(protoMessage *pb.PansProto) (domain.Pans, error) {
+	if protoMessage == nil {
+		return domain.Pans{}, nil
+	}
+
 	pans := make([]domain.Pan, 0, len(protoMessage.Pans))
 
 	for _, p := range protoMessage.Pans {
+		if p == nil {
+			// keep the position so that pan indexes match the request
+			p = &pb.PanProto{}
+		}
+		measures, err := toDomainMeasures(p.Measures)
+		if err != nil {
+			return domain.Pans{}, err
+		}
+
 		pan := domain.Pan{
-			Shape: p.Shape,
-			Measures: domain.Measures{
-				Diameter: toPointer(p.Measures.Diameter),
-				Edge:     toPointer(p.Measures.Edge),
-				Width:    toPointer(p.Measures.Width),
-				Length:   toPointer(p.Measures.Length),
-			},
-			Name: p.Name,
-			Area: p.Area,
+			CatalogID:    p.CatalogId,
+			Shape:        p.Shape,
+			Measures:     measures,
+			DoughOnWalls: p.DoughOnWalls,
+			Name:         p.Name,
+			Area:         p.Area,
+			DoughWeight:  p.DoughWeight,
+			Quantity:     int(p.Quantity),
+			BallCount:    int(p.BallCount),
 		}
 		pans = append(pans, pan)
 	}
 
 	return domain.Pans{
-		Pans:      pans,
-		TotalArea: protoMessage.TotalArea,
-	}
+		Pans:             pans,
+		TotalArea:        protoMessage.TotalArea,
+		ThicknessFactor:  protoMessage.GetThicknessFactor(),
+		RiseFactor:       protoMessage.GetRiseFactor(),
+		TotalDoughWeight: protoMessage.TotalDoughWeight,
+		WasteFactor:      protoMessage.GetWasteFactor(),
+		ScalePrecision:   protoMessage.GetScalePrecision(),
+	}, nil
 }
 
 func toProtoMessage(domainPans *domain.Pans) *pb.PansProto {
@@ -66,26 +282,227 @@ func toProtoMessage(domainPans *domain.Pans) *pb.PansProto {
 
 	for _, p := range domainPans.Pans {
 		panProto := &pb.PanProto{
-			Shape: p.Shape,
-			Measures: &pb.MeasuresProto{
-				Diameter: fromPointer(p.Measures.Diameter),
-				Edge:     fromPointer(p.Measures.Edge),
-				Width:    fromPointer(p.Measures.Width),
-				Length:   fromPointer(p.Measures.Length),
-			},
-			Name: p.Name,
-			Area: p.Area,
+			CatalogId:       p.CatalogID,
+			Shape:           p.Shape,
+			Measures:        toProtoMeasures(p.Measures),
+			DoughOnWalls:    p.DoughOnWalls,
+			Name:            p.Name,
+			Area:            p.Area,
+			DoughArea:       p.DoughArea,
+			ToppingArea:     p.ToppingArea,
+			SideArea:        p.SideArea,
+			Volume:          p.Volume,
+			DoughWeight:     p.DoughWeight,
+			Warnings:        p.Warnings,
+			Quantity:        int32(p.Quantity),
+			LineArea:        p.LineArea,
+			LineDoughWeight: p.LineDoughWeight,
+			Ingredients:     toProtoIngredients(p.Ingredients),
+			BallCount:       int32(p.BallCount),
+			BallWeight:      p.BallWeight,
 		}
 		panProtos = append(panProtos, panProto)
 	}
+	for _, panErr := range domainPans.Errors {
+		panProtos[panErr.Index].Error = toProtoPanError(panErr.Err)
+		// like the pans that fail validation, failed pans carry no bill
+		panProtos[panErr.Index].Ingredients = nil
+	}
+
+	pansProto := &pb.PansProto{
+		Pans:             panProtos,
+		TotalArea:        domainPans.TotalArea,
+		TotalDoughWeight: domainPans.TotalDoughWeight,
+		ThicknessFactor:  &domainPans.ThicknessFactor,
+		RiseFactor:       &domainPans.RiseFactor,
+		Ingredients:      toProtoIngredients(domainPans.Ingredients),
+		WasteFactor:      &domainPans.WasteFactor,
+		ScalePrecision:   &domainPans.ScalePrecision,
+		TotalBallWeight:  domainPans.TotalBallWeight,
+		MixDoughWeight:   domainPans.MixDoughWeight,
+	}
+	if bill := domainPans.PrefermentBill; bill != nil {
+		pansProto.Preferment = toProtoIngredients(bill.Preferment)
+		pansProto.FinalDough = toProtoIngredients(bill.FinalDough)
+	}
+	for _, flour := range domainPans.Flours {
+		pansProto.Flours = append(pansProto.Flours, &pb.BlendFlourProto{
+			Flour:  toProtoFlour(flour.Flour),
+			Share:  flour.Share,
+			Weight: flour.Weight,
+		})
+	}
+	return pansProto
+}
+
+func toDomainFormulaOverrides(formula *pb.FormulaProto) domain.FormulaOverrides {
+	if formula == nil {
+		return domain.FormulaOverrides{}
+	}
+	return domain.FormulaOverrides{
+		Hydration: formula.Hydration,
+		Salt:      formula.Salt,
+		Yeast:     formula.Yeast,
+		Oil:       formula.Oil,
+		Sugar:     formula.Sugar,
+	}
+}
+
+func toProtoFormula(formula domain.Formula) *pb.FormulaProto {
+	return &pb.FormulaProto{
+		Hydration: &formula.Hydration,
+		Salt:      &formula.Salt,
+		Yeast:     &formula.Yeast,
+		Oil:       &formula.Oil,
+		Sugar:     &formula.Sugar,
+		YeastType: string(formula.YeastType),
+	}
+}
+
+func toDomainFermentation(fermentation *pb.FermentationProto) (*domain.Fermentation, error) {
+	if fermentation == nil {
+		return nil, nil
+	}
+	yeastType, err := domain.ParseYeastType(fermentation.YeastType)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.Fermentation{
+		Hours:       fermentation.Hours,
+		Temperature: fermentation.Temperature,
+		YeastType:   yeastType,
+	}, nil
+}
+
+func toDomainPreferment(preferment *pb.PrefermentProto) (*domain.Preferment, error) {
+	if preferment == nil {
+		return nil, nil
+	}
+	prefermentType, err := domain.ParsePrefermentType(preferment.Type)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.Preferment{
+		Type:        prefermentType,
+		FlourShare:  preferment.FlourShare,
+		Hydration:   preferment.GetHydration(),
+		Inoculation: preferment.Inoculation,
+	}, nil
+}
+
+func toProtoPreferment(preferment *domain.Preferment) *pb.PrefermentProto {
+	if preferment == nil {
+		return nil
+	}
+	return &pb.PrefermentProto{
+		Type:        string(preferment.Type),
+		FlourShare:  preferment.FlourShare,
+		Hydration:   &preferment.Hydration,
+		Inoculation: preferment.Inoculation,
+	}
+}
+
+func toProtoIngredients(ingredients domain.Ingredients) *pb.IngredientsProto {
+	return &pb.IngredientsProto{
+		Flour: ingredients.Flour,
+		Water: ingredients.Water,
+		Salt:  ingredients.Salt,
+		Yeast: ingredients.Yeast,
+		Oil:   ingredients.Oil,
+		Sugar: ingredients.Sugar,
+	}
+}
+
+func toDomainMeasures(measures *pb.MeasuresProto) (domain.Measures, error) {
+	if measures == nil {
+		return domain.Measures{}, nil
+	}
+
+	var unit domain.Unit
+	if measures.Unit != "" {
+		parsed, err := domain.ParseUnit(measures.Unit)
+		if err != nil {
+			return domain.Measures{}, err
+		}
+		unit = parsed
+	}
+
+	return domain.Measures{
+		Diameter:      toMeasure(measures.Diameter, measures.DiameterDecimal),
+		Edge:          toMeasure(measures.Edge, measures.EdgeDecimal),
+		Width:         toMeasure(measures.Width, measures.WidthDecimal),
+		Length:        toMeasure(measures.Length, measures.LengthDecimal),
+		MajorAxis:     toMeasure(measures.MajorAxis, measures.MajorAxisDecimal),
+		MinorAxis:     toMeasure(measures.MinorAxis, measures.MinorAxisDecimal),
+		Depth:         measures.Depth,
+		TopDiameter:   measures.TopDiameter,
+		TopEdge:       measures.TopEdge,
+		TopWidth:      measures.TopWidth,
+		TopLength:     measures.TopLength,
+		CornerRadius:  measures.CornerRadius,
+		OuterDiameter: measures.OuterDiameter,
+		InnerDiameter: measures.InnerDiameter,
+		Vertices:      toDomainPoints(measures.Vertices),
+		Sides:         toCount(measures.Sides),
+		Circumradius:  measures.Circumradius,
+		SideA:         measures.SideA,
+		SideB:         measures.SideB,
+		SideC:         measures.SideC,
+		WallThickness: measures.WallThickness,
+		CrustBorder:   measures.CrustBorder,
+		Unit:          unit,
+	}, nil
+}
+
+func toProtoMeasures(measures domain.Measures) *pb.MeasuresProto {
+	measuresProto := &pb.MeasuresProto{
+		Depth:         measures.Depth,
+		TopDiameter:   measures.TopDiameter,
+		TopEdge:       measures.TopEdge,
+		TopWidth:      measures.TopWidth,
+		TopLength:     measures.TopLength,
+		CornerRadius:  measures.CornerRadius,
+		OuterDiameter: measures.OuterDiameter,
+		InnerDiameter: measures.InnerDiameter,
+		Vertices:      toProtoPoints(measures.Vertices),
+		Sides:         fromCount(measures.Sides),
+		Circumradius:  measures.Circumradius,
+		SideA:         measures.SideA,
+		SideB:         measures.SideB,
+		SideC:         measures.SideC,
+		WallThickness: measures.WallThickness,
+		CrustBorder:   measures.CrustBorder,
+		Unit:          string(measures.Unit),
+	}
+	measuresProto.Diameter, measuresProto.DiameterDecimal = fromMeasure(measures.Diameter)
+	measuresProto.Edge, measuresProto.EdgeDecimal = fromMeasure(measures.Edge)
+	measuresProto.Width, measuresProto.WidthDecimal = fromMeasure(measures.Width)
+	measuresProto.Length, measuresProto.LengthDecimal = fromMeasure(measures.Length)
+	measuresProto.MajorAxis, measuresProto.MajorAxisDecimal = fromMeasure(measures.MajorAxis)
+	measuresProto.MinorAxis, measuresProto.MinorAxisDecimal = fromMeasure(measures.MinorAxis)
+	return measuresProto
+}
+
+func toDomainPoints(points []*pb.PointProto) []domain.Point {
+	if len(points) == 0 {
+		return nil
+	}
+	vertices := make([]domain.Point, 0, len(points))
+	for _, p := range points {
+		vertices = append(vertices, domain.Point{X: p.GetX(), Y: p.GetY()})
+	}
+	return vertices
+}
 
-	return &pb.PansProto{
-		Pans:      panProtos,
-		TotalArea: domainPans.TotalArea,
+func toProtoPoints(points []domain.Point) []*pb.PointProto {
+	vertices := make([]*pb.PointProto, 0, len(points))
+	for _, p := range points {
+		vertices = append(vertices, &pb.PointProto{X: p.X, Y: p.Y})
 	}
+	return vertices
 }
 
-func toPointer(value *int32) *int {
+func toCount(value *int32) *int {
 	if value == nil {
 		return nil
 	}
@@ -93,10 +510,36 @@ func toPointer(value *int32) *int {
 	return &val
 }
 
-func fromPointer(value *int) *int32 {
+func fromCount(value *int) *int32 {
 	if value == nil {
 		return nil
 	}
 	val := int32(*value)
 	return &val
 }
+
+func toMeasure(value *int32, decimal *float64) *float64 {
+	if decimal != nil {
+		val := *decimal
+		return &val
+	}
+	if value == nil {
+		return nil
+	}
+	val := float64(*value)
+	return &val
+}
+
+// fromMeasure always fills the decimal field and keeps the integer field
+// for whole values, so clients reading only integers keep working.
+func fromMeasure(value *float64) (*int32, *float64) {
+	if value == nil {
+		return nil, nil
+	}
+	decimal := *value
+	if decimal != math.Trunc(decimal) {
+		return nil, &decimal
+	}
+	val := int32(decimal)
+	return &val, &decimal
+}
@@ -2,19 +2,25 @@ package grpc_test
 
 import (
 	"context"
+	"math"
 	"net"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 	"google.golang.org/grpc/test/bufconn"
 
+	"github.com/cfioretti/calculator/internal/infrastructure/catalog"
 	"github.com/cfioretti/calculator/pkg/application"
 	grpcServer "github.com/cfioretti/calculator/pkg/infrastructure/grpc"
 	pb "github.com/cfioretti/calculator/pkg/infrastructure/grpc/proto/generated"
+	"github.com/cfioretti/calculator/pkg/shapes"
 )
 
 const bufSize = 1024 * 1024
@@ -23,8 +29,10 @@ const bufSize = 1024 * 1024
 func setupGRPCServer(t *testing.T) (*grpc.ClientConn, func()) {
 	lis := bufconn.Listen(bufSize)
 
-	calculatorService := application.NewCalculatorService()
-	server := grpcServer.NewServer(calculatorService)
+	panCatalog := catalog.NewInMemoryPanCatalog()
+	catalogService := application.NewPanCatalogService(panCatalog, shapes.Default())
+	calculatorService := application.NewCalculatorService(application.WithPanCatalog(panCatalog))
+	server := grpcServer.NewServer(calculatorService, grpcServer.WithCatalogService(catalogService))
 	grpcNewServer := grpc.NewServer()
 
 	pb.RegisterDoughCalculatorServer(grpcNewServer, server)
@@ -93,11 +101,13 @@ func TestTotalDoughWeightByPans(t *testing.T) {
 						Measures: &pb.MeasuresProto{
 							Diameter: func() *int32 { d := int32(28); return &d }(),
 						},
-						Name: "round 28 cm",
-						Area: 615.75,
+						Name:        "round 28 cm",
+						Area:        615.75,
+						DoughWeight: 307.88,
 					},
 				},
-				TotalArea: 615.75,
+				TotalArea:        615.75,
+				TotalDoughWeight: 307.88,
 			},
 		},
 		{
@@ -139,8 +149,9 @@ func TestTotalDoughWeightByPans(t *testing.T) {
 						Measures: &pb.MeasuresProto{
 							Diameter: func() *int32 { d := int32(28); return &d }(),
 						},
-						Name: "round 28 cm",
-						Area: 615.75,
+						Name:        "round 28 cm",
+						Area:        615.75,
+						DoughWeight: 307.88,
 					},
 					{
 						Shape: "rectangular",
@@ -148,19 +159,50 @@ func TestTotalDoughWeightByPans(t *testing.T) {
 							Width:  func() *int32 { w := int32(30); return &w }(),
 							Length: func() *int32 { l := int32(40); return &l }(),
 						},
-						Name: "rectangular 30 x 40 cm",
-						Area: 1200.0,
+						Name:        "rectangular 30 x 40 cm",
+						Area:        1200.0,
+						DoughWeight: 600.0,
 					},
 					{
 						Shape: "square",
 						Measures: &pb.MeasuresProto{
 							Edge: func() *int32 { e := int32(25); return &e }(),
 						},
-						Name: "square 25 cm",
-						Area: 625.0,
+						Name:        "square 25 cm",
+						Area:        625.0,
+						DoughWeight: 312.5,
 					},
 				},
-				TotalArea: 2440.75,
+				TotalArea:        2440.75,
+				TotalDoughWeight: 1220.38,
+			},
+		},
+		{
+			name: "Decimal measures",
+			input: &pb.PansProto{
+				Pans: []*pb.PanProto{
+					{
+						Shape: "round",
+						Measures: &pb.MeasuresProto{
+							DiameterDecimal: func() *float64 { d := 28.5; return &d }(),
+						},
+					},
+				},
+			},
+			expected: &pb.PansProto{
+				Pans: []*pb.PanProto{
+					{
+						Shape: "round",
+						Measures: &pb.MeasuresProto{
+							DiameterDecimal: func() *float64 { d := 28.5; return &d }(),
+						},
+						Name:        "round 28.5 cm",
+						Area:        637.94,
+						DoughWeight: 318.97,
+					},
+				},
+				TotalArea:        637.94,
+				TotalDoughWeight: 318.97,
 			},
 		},
 	}
@@ -181,13 +223,20 @@ func TestTotalDoughWeightByPans(t *testing.T) {
 			assert.NotNil(t, response.Pans)
 			assert.Equal(t, len(tc.expected.Pans), len(response.Pans.Pans))
 			assert.Equal(t, tc.expected.TotalArea, response.Pans.TotalArea)
+			assert.Equal(t, tc.expected.TotalDoughWeight, response.Pans.TotalDoughWeight)
 
 			for i, expectedPan := range tc.expected.Pans {
 				actualPan := response.Pans.Pans[i]
 				assert.Equal(t, expectedPan.Shape, actualPan.Shape)
 				assert.Equal(t, expectedPan.Name, actualPan.Name)
 				assert.Equal(t, expectedPan.Area, actualPan.Area)
+				assert.Equal(t, expectedPan.DoughWeight, actualPan.DoughWeight)
 
+				if expectedPan.Measures.DiameterDecimal != nil {
+					assert.NotNil(t, actualPan.Measures.DiameterDecimal)
+					assert.Equal(t, *expectedPan.Measures.DiameterDecimal, *actualPan.Measures.DiameterDecimal)
+					assert.Nil(t, actualPan.Measures.Diameter)
+				}
 				if expectedPan.Measures.Diameter != nil {
 					assert.NotNil(t, actualPan.Measures.Diameter)
 					assert.Equal(t, *expectedPan.Measures.Diameter, *actualPan.Measures.Diameter)
@@ -208,3 +257,816 @@ func TestTotalDoughWeightByPans(t *testing.T) {
 		})
 	}
 }
+
+func TestTotalDoughWeightByPansWithUnit(t *testing.T) {
+	conn, cleanup := setupGRPCServer(t)
+	defer cleanup()
+
+	client := pb.NewDoughCalculatorClient(conn)
+
+	edge := int32(12)
+	pans := &pb.PansProto{
+		Pans: []*pb.PanProto{
+			{
+				Shape:    "square",
+				Measures: &pb.MeasuresProto{Edge: &edge},
+			},
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	response, err := client.TotalDoughWeightByPans(ctx, &pb.PansRequest{Pans: pans, Unit: "in"})
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), response.Pans.Pans[0].Quantity)
+	assert.Equal(t, "in", response.Unit)
+	assert.Equal(t, "square 12 in", response.Pans.Pans[0].Name)
+	assert.Equal(t, 144.0, response.Pans.Pans[0].Area)
+	assert.Equal(t, 464.52, response.Pans.Pans[0].DoughWeight)
+
+	_, err = client.TotalDoughWeightByPans(ctx, &pb.PansRequest{Pans: pans, Unit: "ft"})
+	require.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestListShapes(t *testing.T) {
+	conn, cleanup := setupGRPCServer(t)
+	defer cleanup()
+
+	client := pb.NewDoughCalculatorClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	response, err := client.ListShapes(ctx, &pb.ListShapesRequest{Unit: "in"})
+	require.NoError(t, err)
+	assert.Equal(t, "in", response.Unit)
+
+	var round *pb.ShapeProto
+	for _, shape := range response.Shapes {
+		if shape.Name == "round" {
+			round = shape
+		}
+	}
+	require.NotNil(t, round)
+	assert.Contains(t, round.Aliases, "circle")
+	require.NotEmpty(t, round.Measures)
+
+	diameter := round.Measures[0]
+	assert.Equal(t, "diameter", diameter.Name)
+	assert.True(t, diameter.Required)
+	assert.Equal(t, "in", diameter.Unit)
+	assert.InDelta(t, 78.74, diameter.Max, 0.01)
+
+	_, err = client.ListShapes(ctx, &pb.ListShapesRequest{Unit: "ft"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestTotalDoughWeightByPansWithQuantity(t *testing.T) {
+	conn, cleanup := setupGRPCServer(t)
+	defer cleanup()
+
+	client := pb.NewDoughCalculatorClient(conn)
+
+	width, length := int32(25), int32(35)
+	request := &pb.PansRequest{
+		Pans: &pb.PansProto{
+			Pans: []*pb.PanProto{
+				{
+					Shape:    "rectangular",
+					Measures: &pb.MeasuresProto{Width: &width, Length: &length},
+					Quantity: 12,
+				},
+			},
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	response, err := client.TotalDoughWeightByPans(ctx, request)
+	require.NoError(t, err)
+
+	pan := response.Pans.Pans[0]
+	assert.Equal(t, int32(12), pan.Quantity)
+	assert.Equal(t, 875.0, pan.Area)
+	assert.Equal(t, 437.5, pan.DoughWeight)
+	assert.Equal(t, 10500.0, pan.LineArea)
+	assert.Equal(t, 5250.0, pan.LineDoughWeight)
+	assert.Equal(t, 10500.0, response.Pans.TotalArea)
+	assert.Equal(t, 5250.0, response.Pans.TotalDoughWeight)
+}
+
+func TestCatalogPans(t *testing.T) {
+	conn, cleanup := setupGRPCServer(t)
+	defer cleanup()
+
+	client := pb.NewDoughCalculatorClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	width, length := int32(10), int32(14)
+	detroit := &pb.CatalogPanProto{
+		Id:          "detroit-10x14",
+		Shape:       "rectangular",
+		Measures:    &pb.MeasuresProto{Width: &width, Length: &length, Unit: "in"},
+		Description: "Detroit steel pan",
+	}
+
+	created, err := client.CreateCatalogPan(ctx, &pb.CatalogPanRequest{Pan: detroit})
+	require.NoError(t, err)
+	assert.Equal(t, "detroit-10x14", created.Pan.Id)
+	assert.Equal(t, "in", created.Pan.Measures.Unit)
+
+	_, err = client.CreateCatalogPan(ctx, &pb.CatalogPanRequest{Pan: detroit})
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	_, err = client.CreateCatalogPan(ctx, &pb.CatalogPanRequest{Pan: &pb.CatalogPanProto{Id: "star-30", Shape: "star", Measures: &pb.MeasuresProto{}}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	listed, err := client.ListCatalogPans(ctx, &pb.ListCatalogPansRequest{})
+	require.NoError(t, err)
+	assert.Len(t, listed.Pans, 1)
+
+	response, err := client.TotalDoughWeightByPans(ctx, &pb.PansRequest{
+		Pans: &pb.PansProto{Pans: []*pb.PanProto{{CatalogId: "detroit-10x14", Quantity: 3}}},
+	})
+	require.NoError(t, err)
+	pan := response.Pans.Pans[0]
+	assert.Equal(t, "detroit-10x14", pan.CatalogId)
+	assert.Equal(t, "rectangular", pan.Shape)
+	assert.Equal(t, "rectangular 10 x 14 in", pan.Name)
+	assert.Equal(t, 903.22, pan.Area)
+	assert.Equal(t, 2709.66, pan.LineArea)
+
+	length = 12
+	updated, err := client.UpdateCatalogPan(ctx, &pb.CatalogPanRequest{Pan: detroit})
+	require.NoError(t, err)
+	assert.Equal(t, int32(12), *updated.Pan.Measures.Length)
+
+	_, err = client.DeleteCatalogPan(ctx, &pb.CatalogPanIdRequest{Id: "detroit-10x14"})
+	require.NoError(t, err)
+
+	_, err = client.GetCatalogPan(ctx, &pb.CatalogPanIdRequest{Id: "detroit-10x14"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestTotalDoughWeightByPansValidation(t *testing.T) {
+	conn, cleanup := setupGRPCServer(t)
+	defer cleanup()
+
+	client := pb.NewDoughCalculatorClient(conn)
+
+	zero, negative, diameter, edge := int32(0), int32(-5), int32(30), int32(300)
+	thicknessFactor, riseFactor := 0.0, 0.5
+	nan, infinity := math.NaN(), math.Inf(1)
+	tooManyPans := make([]*pb.PanProto, 101)
+	for i := range tooManyPans {
+		tooManyPans[i] = &pb.PanProto{Shape: "round", Measures: &pb.MeasuresProto{Diameter: &diameter}}
+	}
+
+	tests := []struct {
+		name       string
+		request    *pb.PansRequest
+		wantFields []string
+	}{
+		{
+			name:       "Nil pans",
+			request:    &pb.PansRequest{},
+			wantFields: []string{"pans"},
+		},
+		{
+			name: "Empty pan and nil measures",
+			request: &pb.PansRequest{Pans: &pb.PansProto{Pans: []*pb.PanProto{
+				{},
+				{Shape: "round"},
+			}}},
+			wantFields: []string{"pans.pans[0].shape", "pans.pans[0].measures", "pans.pans[1].measures"},
+		},
+		{
+			name: "Zero and negative measures",
+			request: &pb.PansRequest{Pans: &pb.PansProto{Pans: []*pb.PanProto{
+				{Shape: "round", Measures: &pb.MeasuresProto{Diameter: &zero}},
+				{Shape: "square", Measures: &pb.MeasuresProto{Edge: &negative}},
+			}}},
+			wantFields: []string{"pans.pans[0].measures.diameter", "pans.pans[1].measures.edge"},
+		},
+		{
+			name: "Measure out of range in the request unit",
+			request: &pb.PansRequest{Unit: "in", Pans: &pb.PansProto{Pans: []*pb.PanProto{
+				{Shape: "square", Measures: &pb.MeasuresProto{Edge: &edge}},
+			}}},
+			wantFields: []string{"pans.pans[0].measures.edge"},
+		},
+		{
+			name: "Missing and unsupported shapes",
+			request: &pb.PansRequest{Pans: &pb.PansProto{Pans: []*pb.PanProto{
+				{Measures: &pb.MeasuresProto{Diameter: &diameter}},
+				{Shape: "star", Measures: &pb.MeasuresProto{Diameter: &diameter}},
+				{Shape: "rectangular", Measures: &pb.MeasuresProto{Width: &diameter}},
+			}}},
+			wantFields: []string{"pans.pans[0].shape", "pans.pans[1].shape", "pans.pans[2].measures.length"},
+		},
+		{
+			name: "Invalid factors and quantity",
+			request: &pb.PansRequest{Pans: &pb.PansProto{
+				ThicknessFactor: &thicknessFactor,
+				RiseFactor:      &riseFactor,
+				Pans: []*pb.PanProto{
+					{Shape: "round", Measures: &pb.MeasuresProto{Diameter: &diameter}, Quantity: -1},
+				},
+			}},
+			wantFields: []string{"pans.thicknessFactor", "pans.riseFactor", "pans.pans[0].quantity"},
+		},
+		{
+			name: "Non-finite measures and factors",
+			request: &pb.PansRequest{
+				Formula: &pb.FormulaProto{Hydration: &nan},
+				Pans: &pb.PansProto{
+					ThicknessFactor: &nan,
+					WasteFactor:     &infinity,
+					Pans: []*pb.PanProto{
+						{Shape: "round", Measures: &pb.MeasuresProto{DiameterDecimal: &nan}},
+						{Shape: "round", Measures: &pb.MeasuresProto{Diameter: &diameter, Depth: &infinity}},
+						{Shape: "polygon", Measures: &pb.MeasuresProto{Vertices: []*pb.PointProto{{X: 0, Y: 0}, {X: nan, Y: 0}, {X: 0, Y: 10}}}},
+					},
+				},
+			},
+			wantFields: []string{
+				"formula.hydration",
+				"pans.thicknessFactor",
+				"pans.wasteFactor",
+				"pans.pans[0].measures.diameter",
+				"pans.pans[1].measures.depth",
+				"pans.pans[2].measures.vertices[1]",
+			},
+		},
+		{
+			name:       "Too many pans",
+			request:    &pb.PansRequest{Pans: &pb.PansProto{Pans: tooManyPans}},
+			wantFields: []string{"pans.pans"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+
+			_, err := client.TotalDoughWeightByPans(ctx, tt.request)
+			require.Error(t, err)
+
+			st := status.Convert(err)
+			assert.Equal(t, codes.InvalidArgument, st.Code())
+			require.Len(t, st.Details(), 1)
+			badRequest, ok := st.Details()[0].(*errdetails.BadRequest)
+			require.True(t, ok)
+
+			var fields []string
+			for _, violation := range badRequest.FieldViolations {
+				fields = append(fields, violation.Field)
+				assert.NotEmpty(t, violation.Description)
+			}
+			assert.Equal(t, tt.wantFields, fields)
+		})
+	}
+}
+
+func TestTotalDoughWeightByPansErrorCodes(t *testing.T) {
+	conn, cleanup := setupGRPCServer(t)
+	defer cleanup()
+
+	client := pb.NewDoughCalculatorClient(conn)
+
+	diameter, majorAxis, minorAxis := int32(30), int32(20), int32(30)
+	round := &pb.PanProto{Shape: "round", Measures: &pb.MeasuresProto{Diameter: &diameter}}
+
+	tests := []struct {
+		name      string
+		pan       *pb.PanProto
+		wantCode  codes.Code
+		wantField string
+	}{
+		{
+			name:      "Invalid measures",
+			pan:       &pb.PanProto{Shape: "oval", Measures: &pb.MeasuresProto{MajorAxis: &majorAxis, MinorAxis: &minorAxis}},
+			wantCode:  codes.InvalidArgument,
+			wantField: "pans.pans[1].measures",
+		},
+		{
+			name:     "Unknown catalog pan",
+			pan:      &pb.PanProto{CatalogId: "missing"},
+			wantCode: codes.NotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+
+			_, err := client.TotalDoughWeightByPans(ctx, &pb.PansRequest{
+				Pans: &pb.PansProto{Pans: []*pb.PanProto{round, tt.pan}},
+			})
+			require.Error(t, err)
+
+			st := status.Convert(err)
+			assert.Equal(t, tt.wantCode, st.Code())
+			assert.Contains(t, st.Message(), "pan 1")
+			if tt.wantField == "" {
+				assert.Empty(t, st.Details())
+				return
+			}
+
+			require.Len(t, st.Details(), 1)
+			badRequest, ok := st.Details()[0].(*errdetails.BadRequest)
+			require.True(t, ok)
+			require.Len(t, badRequest.FieldViolations, 1)
+			assert.Equal(t, tt.wantField, badRequest.FieldViolations[0].Field)
+		})
+	}
+}
+
+func TestTotalDoughWeightByPansPartial(t *testing.T) {
+	conn, cleanup := setupGRPCServer(t)
+	defer cleanup()
+
+	client := pb.NewDoughCalculatorClient(conn)
+
+	edge, zero, majorAxis, minorAxis := int32(20), int32(0), int32(20), int32(30)
+	request := &pb.PansRequest{
+		Partial: true,
+		Pans: &pb.PansProto{Pans: []*pb.PanProto{
+			{Shape: "oval", Measures: &pb.MeasuresProto{MajorAxis: &majorAxis, MinorAxis: &minorAxis}, Quantity: 2},
+			{Shape: "square", Measures: &pb.MeasuresProto{Edge: &edge}},
+			{Shape: "round", Measures: &pb.MeasuresProto{Diameter: &zero}, Quantity: 2},
+			{CatalogId: "missing"},
+		}},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	response, err := client.TotalDoughWeightByPans(ctx, request)
+	require.NoError(t, err)
+
+	pans := response.Pans.Pans
+	require.Len(t, pans, 4)
+
+	assert.Equal(t, "InvalidArgument", pans[0].Error.GetCode())
+	assert.Equal(t, []string{"measures"}, pans[0].Error.GetFields())
+	assert.Equal(t, "oval", pans[0].Shape)
+	assert.Equal(t, int32(2), pans[0].Quantity)
+	assert.Nil(t, pans[0].Ingredients)
+
+	assert.Nil(t, pans[1].Error)
+	assert.Equal(t, "square 20 cm", pans[1].Name)
+	assert.NotNil(t, pans[1].Ingredients)
+
+	assert.Equal(t, "InvalidArgument", pans[2].Error.GetCode())
+	assert.Equal(t, []string{"measures.diameter"}, pans[2].Error.GetFields())
+	assert.Equal(t, int32(2), pans[2].Quantity)
+	assert.Nil(t, pans[2].Ingredients)
+
+	assert.Equal(t, "NotFound", pans[3].Error.GetCode())
+	assert.Equal(t, []string{"catalogId"}, pans[3].Error.GetFields())
+
+	assert.Equal(t, 400.0, response.Pans.TotalArea)
+	assert.Equal(t, 200.0, response.Pans.TotalDoughWeight)
+
+	request.Pans.RiseFactor = new(float64)
+	_, err = client.TotalDoughWeightByPans(ctx, request)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestTotalDoughWeightByPansStrict(t *testing.T) {
+	conn, cleanup := setupGRPCServer(t)
+	defer cleanup()
+
+	client := pb.NewDoughCalculatorClient(conn)
+
+	edge, diameter := int32(20), int32(30)
+	request := &pb.PansRequest{
+		Strict: true,
+		Pans: &pb.PansProto{Pans: []*pb.PanProto{
+			{Shape: "square", Measures: &pb.MeasuresProto{Edge: &edge, Diameter: &diameter}},
+		}},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	_, err := client.TotalDoughWeightByPans(ctx, request)
+	require.Error(t, err)
+
+	st := status.Convert(err)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	require.Len(t, st.Details(), 1)
+	badRequest, ok := st.Details()[0].(*errdetails.BadRequest)
+	require.True(t, ok)
+	require.Len(t, badRequest.FieldViolations, 1)
+	assert.Equal(t, "pans.pans[0].measures.diameter", badRequest.FieldViolations[0].Field)
+	assert.Equal(t, "diameter is not a measure of shape square", badRequest.FieldViolations[0].Description)
+
+	request.Strict = false
+	response, err := client.TotalDoughWeightByPans(ctx, request)
+	require.NoError(t, err)
+	assert.Equal(t, 400.0, response.Pans.TotalArea)
+
+	// an ignored measure set to zero is ignored, a negative one is not
+	cornerRadius := 0.0
+	request.Pans.Pans[0] = &pb.PanProto{Shape: "round", Measures: &pb.MeasuresProto{Diameter: &diameter, CornerRadius: &cornerRadius}}
+	_, err = client.TotalDoughWeightByPans(ctx, request)
+	require.NoError(t, err)
+
+	cornerRadius = -1
+	_, err = client.TotalDoughWeightByPans(ctx, request)
+	st = status.Convert(err)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	require.Len(t, st.Details(), 1)
+	badRequest, ok = st.Details()[0].(*errdetails.BadRequest)
+	require.True(t, ok)
+	require.Len(t, badRequest.FieldViolations, 1)
+	assert.Equal(t, "pans.pans[0].measures.cornerRadius", badRequest.FieldViolations[0].Field)
+}
+
+func TestTotalDoughWeightByPansWithRim(t *testing.T) {
+	conn, cleanup := setupGRPCServer(t)
+	defer cleanup()
+
+	client := pb.NewDoughCalculatorClient(conn)
+
+	diameter, wall, border := int32(30), 0.5, 2.0
+	request := &pb.PansRequest{
+		Pans: &pb.PansProto{Pans: []*pb.PanProto{
+			{Shape: "round", Measures: &pb.MeasuresProto{Diameter: &diameter, WallThickness: &wall, CrustBorder: &border}},
+		}},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	response, err := client.TotalDoughWeightByPans(ctx, request)
+	require.NoError(t, err)
+
+	pan := response.Pans.Pans[0]
+	assert.Equal(t, "round 30 cm, wall 0.5 cm, border 2 cm", pan.Name)
+	assert.Equal(t, 706.86, pan.Area)
+	assert.Equal(t, 660.52, pan.DoughArea)
+	assert.Equal(t, 490.87, pan.ToppingArea)
+	assert.Equal(t, 330.26, pan.DoughWeight)
+	assert.Equal(t, 0.5, pan.Measures.GetWallThickness())
+}
+
+func TestStyles(t *testing.T) {
+	conn, cleanup := setupGRPCServer(t)
+	defer cleanup()
+
+	client := pb.NewDoughCalculatorClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	list, err := client.ListStyles(ctx, &pb.ListStylesRequest{})
+	require.NoError(t, err)
+	require.Len(t, list.Styles, 6)
+
+	var neapolitan *pb.StyleProto
+	for _, style := range list.Styles {
+		if style.Id == "neapolitan" {
+			neapolitan = style
+		}
+	}
+	require.NotNil(t, neapolitan)
+
+	edge := int32(20)
+	request := &pb.PansRequest{
+		Style: "neapolitan",
+		Pans: &pb.PansProto{Pans: []*pb.PanProto{
+			{Shape: "square", Measures: &pb.MeasuresProto{Edge: &edge}},
+		}},
+	}
+	response, err := client.TotalDoughWeightByPans(ctx, request)
+	require.NoError(t, err)
+	assert.Equal(t, "neapolitan", response.Style.GetId())
+	assert.Equal(t, neapolitan.ThicknessFactor, response.Pans.GetThicknessFactor())
+	assert.Equal(t, 400*neapolitan.ThicknessFactor, response.Pans.TotalDoughWeight)
+
+	request.Style = "chicago"
+	_, err = client.TotalDoughWeightByPans(ctx, request)
+	st := status.Convert(err)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	require.Len(t, st.Details(), 1)
+	badRequest, ok := st.Details()[0].(*errdetails.BadRequest)
+	require.True(t, ok)
+	assert.Equal(t, "style", badRequest.FieldViolations[0].Field)
+}
+
+func TestTotalDoughWeightByPansIngredients(t *testing.T) {
+	conn, cleanup := setupGRPCServer(t)
+	defer cleanup()
+
+	client := pb.NewDoughCalculatorClient(conn)
+
+	width, length := int32(30), int32(40)
+	hydration, salt, yeast, oil, sugar := 70.0, 3.0, 1.0, 3.0, 3.0
+	request := &pb.PansRequest{
+		Formula: &pb.FormulaProto{Hydration: &hydration, Salt: &salt, Yeast: &yeast, Oil: &oil, Sugar: &sugar},
+		Pans: &pb.PansProto{Pans: []*pb.PanProto{
+			{Shape: "rectangular", Measures: &pb.MeasuresProto{Width: &width, Length: &length}, Quantity: 3},
+		}},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	response, err := client.TotalDoughWeightByPans(ctx, request)
+	require.NoError(t, err)
+
+	assert.Equal(t, 70.0, response.Formula.GetHydration())
+	pan := response.Pans.Pans[0].Ingredients
+	assert.Equal(t, 333.33, pan.Flour)
+	assert.Equal(t, 233.33, pan.Water)
+	assert.Equal(t, 3.33, pan.Yeast)
+	total := response.Pans.Ingredients
+	assert.Equal(t, 1000.0, total.Flour)
+	assert.Equal(t, 700.0, total.Water)
+	assert.Equal(t, 30.0, total.Salt)
+	assert.Equal(t, 10.0, total.Yeast)
+
+	hydration = 200
+	_, err = client.TotalDoughWeightByPans(ctx, request)
+	st := status.Convert(err)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	require.Len(t, st.Details(), 1)
+	badRequest, ok := st.Details()[0].(*errdetails.BadRequest)
+	require.True(t, ok)
+	assert.Equal(t, "formula.hydration", badRequest.FieldViolations[0].Field)
+}
+
+func TestTotalDoughWeightByPansFermentation(t *testing.T) {
+	conn, cleanup := setupGRPCServer(t)
+	defer cleanup()
+
+	client := pb.NewDoughCalculatorClient(conn)
+
+	width, length := int32(30), int32(40)
+	request := &pb.PansRequest{
+		Fermentation: &pb.FermentationProto{Hours: 8, Temperature: 24, YeastType: "instant"},
+		Pans: &pb.PansProto{Pans: []*pb.PanProto{
+			{Shape: "rectangular", Measures: &pb.MeasuresProto{Width: &width, Length: &length}},
+		}},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	response, err := client.TotalDoughWeightByPans(ctx, request)
+	require.NoError(t, err)
+	assert.Equal(t, 0.165, response.Formula.GetYeast())
+	assert.Equal(t, "instant", response.Formula.YeastType)
+
+	// 1 hour at 2 °C would need about 30% fresh yeast
+	request.Fermentation = &pb.FermentationProto{Hours: 1, Temperature: 2}
+	_, err = client.TotalDoughWeightByPans(ctx, request)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	request.Fermentation = &pb.FermentationProto{Hours: 0, Temperature: 50, YeastType: "sourdough"}
+	_, err = client.TotalDoughWeightByPans(ctx, request)
+	st := status.Convert(err)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	require.Len(t, st.Details(), 1)
+	badRequest, ok := st.Details()[0].(*errdetails.BadRequest)
+	require.True(t, ok)
+	fields := make([]string, 0, len(badRequest.FieldViolations))
+	for _, violation := range badRequest.FieldViolations {
+		fields = append(fields, violation.Field)
+	}
+	assert.Equal(t, []string{"fermentation.hours", "fermentation.temperature", "fermentation.yeastType"}, fields)
+}
+
+func TestTotalDoughWeightByPansPreferment(t *testing.T) {
+	conn, cleanup := setupGRPCServer(t)
+	defer cleanup()
+
+	client := pb.NewDoughCalculatorClient(conn)
+
+	width, length := int32(30), int32(40)
+	hydration := 45.0
+	request := &pb.PansRequest{
+		Preferment: &pb.PrefermentProto{Type: "biga", FlourShare: 40, Hydration: &hydration},
+		Pans: &pb.PansProto{Pans: []*pb.PanProto{
+			{Shape: "rectangular", Measures: &pb.MeasuresProto{Width: &width, Length: &length}},
+		}},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	response, err := client.TotalDoughWeightByPans(ctx, request)
+	require.NoError(t, err)
+	assert.Equal(t, "biga", response.Preferment.Type)
+	assert.Equal(t, 45.0, response.Preferment.GetHydration())
+	total, pre, final := response.Pans.Ingredients, response.Pans.Preferment, response.Pans.FinalDough
+	require.NotNil(t, pre)
+	require.NotNil(t, final)
+	assert.InDelta(t, total.Flour, pre.Flour+final.Flour, 1e-9)
+	assert.InDelta(t, total.Water, pre.Water+final.Water, 1e-9)
+
+	request.Preferment = &pb.PrefermentProto{Type: "sponge", FlourShare: 0}
+	_, err = client.TotalDoughWeightByPans(ctx, request)
+	st := status.Convert(err)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	require.Len(t, st.Details(), 1)
+	badRequest, ok := st.Details()[0].(*errdetails.BadRequest)
+	require.True(t, ok)
+	require.Len(t, badRequest.FieldViolations, 2)
+	assert.Equal(t, "preferment.type", badRequest.FieldViolations[0].Field)
+	assert.Equal(t, "preferment.flourShare", badRequest.FieldViolations[1].Field)
+
+	request.Preferment = &pb.PrefermentProto{Type: "poolish", FlourShare: 90}
+	_, err = client.TotalDoughWeightByPans(ctx, request)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestTotalDoughWeightByPansLevain(t *testing.T) {
+	conn, cleanup := setupGRPCServer(t)
+	defer cleanup()
+
+	client := pb.NewDoughCalculatorClient(conn)
+
+	width, length := int32(30), int32(40)
+	hydration, yeast := 70.0, 0.0
+	request := &pb.PansRequest{
+		Formula:    &pb.FormulaProto{Hydration: &hydration, Yeast: &yeast},
+		Preferment: &pb.PrefermentProto{Type: "levain", Inoculation: 20},
+		Pans: &pb.PansProto{Pans: []*pb.PanProto{
+			{Shape: "rectangular", Measures: &pb.MeasuresProto{Width: &width, Length: &length}},
+		}},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	response, err := client.TotalDoughWeightByPans(ctx, request)
+	require.NoError(t, err)
+	assert.Equal(t, 10.0, response.Preferment.FlourShare)
+	assert.Equal(t, 20.0, response.Preferment.Inoculation)
+
+	total, levain, final := response.Pans.Ingredients, response.Pans.Preferment, response.Pans.FinalDough
+	assert.InDelta(t, total.Flour*0.2, levain.Flour+levain.Water, 0.01)
+	assert.InDelta(t, total.Flour, levain.Flour+final.Flour, 1e-9)
+	assert.InDelta(t, total.Water, levain.Water+final.Water, 1e-9)
+	assert.Zero(t, levain.Yeast)
+
+	request.Preferment = &pb.PrefermentProto{Type: "levain", FlourShare: 10, Inoculation: 20}
+	_, err = client.TotalDoughWeightByPans(ctx, request)
+	st := status.Convert(err)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	require.Len(t, st.Details(), 1)
+	badRequest, ok := st.Details()[0].(*errdetails.BadRequest)
+	require.True(t, ok)
+	assert.Equal(t, "preferment.inoculation", badRequest.FieldViolations[0].Field)
+}
+
+func TestTotalDoughWeightByPansFlourBlend(t *testing.T) {
+	conn, cleanup := setupGRPCServer(t)
+	defer cleanup()
+
+	client := pb.NewDoughCalculatorClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	list, err := client.ListFlours(ctx, &pb.ListFloursRequest{})
+	require.NoError(t, err)
+	ids := make([]string, 0, len(list.Flours))
+	for _, flour := range list.Flours {
+		ids = append(ids, flour.Id)
+	}
+	assert.Contains(t, ids, "tipo_00")
+	assert.Contains(t, ids, "whole_wheat")
+
+	width, length := int32(30), int32(40)
+	request := &pb.PansRequest{
+		Blend: []*pb.BlendPartProto{{Flour: "tipo_00", Share: 80}, {Flour: "whole_wheat", Share: 20}},
+		Pans: &pb.PansProto{Pans: []*pb.PanProto{
+			{Shape: "rectangular", Measures: &pb.MeasuresProto{Width: &width, Length: &length}},
+		}},
+	}
+
+	response, err := client.TotalDoughWeightByPans(ctx, request)
+	require.NoError(t, err)
+	assert.Equal(t, 2.0, response.HydrationAdjustment)
+	assert.Equal(t, 67.0, response.Formula.GetHydration())
+	require.Len(t, response.Pans.Flours, 2)
+	assert.Equal(t, "whole_wheat", response.Pans.Flours[1].Flour.Id)
+	assert.Equal(t, 10.0, response.Pans.Flours[1].Flour.Absorption)
+	assert.InDelta(t, response.Pans.Ingredients.Flour, response.Pans.Flours[0].Weight+response.Pans.Flours[1].Weight, 1e-9)
+
+	request.Blend = []*pb.BlendPartProto{{Flour: "cassava", Share: 80}, {Flour: "tipo_00", Share: 10}}
+	_, err = client.TotalDoughWeightByPans(ctx, request)
+	st := status.Convert(err)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	require.Len(t, st.Details(), 1)
+	badRequest, ok := st.Details()[0].(*errdetails.BadRequest)
+	require.True(t, ok)
+	require.Len(t, badRequest.FieldViolations, 2)
+	assert.Equal(t, "blend[0].flour", badRequest.FieldViolations[0].Field)
+	assert.Equal(t, "blend", badRequest.FieldViolations[1].Field)
+
+	request.Blend = []*pb.BlendPartProto{{Flour: "tipo_00", Share: 100}, {Flour: "whole_wheat", Share: math.NaN()}}
+	_, err = client.TotalDoughWeightByPans(ctx, request)
+	st = status.Convert(err)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	require.Len(t, st.Details(), 1)
+	badRequest, ok = st.Details()[0].(*errdetails.BadRequest)
+	require.True(t, ok)
+	require.Len(t, badRequest.FieldViolations, 1)
+	assert.Equal(t, "blend[1].share", badRequest.FieldViolations[0].Field)
+}
+
+func TestTotalDoughWeightByPansBalls(t *testing.T) {
+	conn, cleanup := setupGRPCServer(t)
+	defer cleanup()
+
+	client := pb.NewDoughCalculatorClient(conn)
+
+	width, length := int32(30), int32(40)
+	wasteFactor, scalePrecision := 1.03, 5.0
+	request := &pb.PansRequest{
+		Pans: &pb.PansProto{
+			WasteFactor:    &wasteFactor,
+			ScalePrecision: &scalePrecision,
+			Pans: []*pb.PanProto{
+				{Shape: "rectangular", Measures: &pb.MeasuresProto{Width: &width, Length: &length}, BallCount: 2, Quantity: 3},
+			},
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	response, err := client.TotalDoughWeightByPans(ctx, request)
+	require.NoError(t, err)
+	pan := response.Pans.Pans[0]
+	assert.Equal(t, int32(2), pan.BallCount)
+	assert.Equal(t, 310.0, pan.BallWeight)
+	assert.Equal(t, 1860.0, response.Pans.TotalBallWeight)
+	assert.Equal(t, 1.03, response.Pans.GetWasteFactor())
+	assert.Equal(t, 1800.0, response.Pans.TotalDoughWeight)
+	assert.Equal(t, 1860.0, response.Pans.MixDoughWeight)
+	total := response.Pans.Ingredients
+	assert.InDelta(t, 1860.0, total.Flour+total.Water+total.Salt+total.Yeast+total.Oil+total.Sugar, 0.05)
+
+	wasteFactor = 0.5
+	request.Pans.Pans[0].BallCount = -1
+	_, err = client.TotalDoughWeightByPans(ctx, request)
+	st := status.Convert(err)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	require.Len(t, st.Details(), 1)
+	badRequest, ok := st.Details()[0].(*errdetails.BadRequest)
+	require.True(t, ok)
+	require.Len(t, badRequest.FieldViolations, 2)
+	assert.Equal(t, "pans.wasteFactor", badRequest.FieldViolations[0].Field)
+	assert.Equal(t, "pans.pans[0].ballCount", badRequest.FieldViolations[1].Field)
+}
+
+func TestTotalDoughWeightByPansBallsTooLight(t *testing.T) {
+	conn, cleanup := setupGRPCServer(t)
+	defer cleanup()
+
+	client := pb.NewDoughCalculatorClient(conn)
+
+	diameter, scalePrecision := int32(20), 100.0
+	request := &pb.PansRequest{
+		Pans: &pb.PansProto{
+			ScalePrecision: &scalePrecision,
+			Pans: []*pb.PanProto{
+				{Shape: "round", Measures: &pb.MeasuresProto{Diameter: &diameter}, BallCount: 100},
+			},
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	_, err := client.TotalDoughWeightByPans(ctx, request)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestTotalDoughWeightByPansNilVertex(t *testing.T) {
+	server := grpcServer.NewServer(application.NewCalculatorService())
+
+	request := &pb.PansRequest{
+		Pans: &pb.PansProto{Pans: []*pb.PanProto{
+			{Shape: "polygon", Measures: &pb.MeasuresProto{Vertices: []*pb.PointProto{{X: 10, Y: 0}, nil, {X: 0, Y: 10}}}},
+		}},
+	}
+
+	response, err := server.TotalDoughWeightByPans(context.Background(), request)
+	require.NoError(t, err)
+	assert.Equal(t, 50.0, response.Pans.TotalArea)
+}
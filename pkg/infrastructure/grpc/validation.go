@@ -0,0 +1,401 @@
+package grpc
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cfioretti/calculator/internal/domain/flours"
+	"github.com/cfioretti/calculator/internal/domain/preferment"
+	"github.com/cfioretti/calculator/internal/domain/yeast"
+	"github.com/cfioretti/calculator/pkg/domain"
+	pb "github.com/cfioretti/calculator/pkg/infrastructure/grpc/proto/generated"
+	"github.com/cfioretti/calculator/pkg/shapes"
+)
+
+const (
+	maxPansPerRequest  = 100
+	maxPanQuantity     = 1000
+	maxThicknessFactor = 5.0
+	maxRiseFactor      = 10.0
+	maxWasteFactor     = 1.5
+	maxScalePrecision  = 100.0
+	maxBallsPerPan     = 100
+)
+
+// formulaRanges bounds the baker's percentages a request can set.
+var formulaRanges = []struct {
+	name     string
+	min, max float64
+	value    func(*pb.FormulaProto) *float64
+}{
+	{"hydration", 40, 120, func(f *pb.FormulaProto) *float64 { return f.Hydration }},
+	{"salt", 0, 5, func(f *pb.FormulaProto) *float64 { return f.Salt }},
+	{"yeast", 0, yeast.MaxPercentage, func(f *pb.FormulaProto) *float64 { return f.Yeast }},
+	{"oil", 0, 20, func(f *pb.FormulaProto) *float64 { return f.Oil }},
+	{"sugar", 0, 20, func(f *pb.FormulaProto) *float64 { return f.Sugar }},
+}
+
+// pansRequestValidator collects every field violation of a PansRequest so
+// that clients can fix all of them in one round trip.
+type pansRequestValidator struct {
+	shapes     map[string]shapes.Shape
+	styles     map[string]bool
+	flours     map[string]bool
+	violations []*errdetails.BadRequest_FieldViolation
+	// pans holds the index of the pan each violation is about, or -1 for
+	// violations of the request itself
+	pans   []int
+	pan    int
+	strict bool
+}
+
+func newPansRequestValidator(supportedShapes []shapes.Shape, styles []domain.Style, supportedFlours []domain.Flour) *pansRequestValidator {
+	styleIDs := make(map[string]bool, len(styles))
+	for _, style := range styles {
+		styleIDs[normalizeName(style.ID)] = true
+	}
+	flourIDs := make(map[string]bool, len(supportedFlours))
+	for _, flour := range supportedFlours {
+		flourIDs[normalizeName(flour.ID)] = true
+	}
+
+	byName := make(map[string]shapes.Shape)
+	for _, shape := range supportedShapes {
+		byName[normalizeName(shape.Name)] = shape
+		for _, alias := range shape.Aliases {
+			byName[normalizeName(alias)] = shape
+		}
+	}
+	return &pansRequestValidator{shapes: byName, styles: styleIDs, flours: flourIDs, pan: -1}
+}
+
+// validatePansRequest returns an InvalidArgument status carrying a
+// BadRequest detail, or nil when the request is valid. In partial mode only
+// violations of the request itself fail it; the pans that are invalid are
+// returned with their errors, keyed by index.
+func validatePansRequest(req *pb.PansRequest, supportedShapes []shapes.Shape, styles []domain.Style, supportedFlours []domain.Flour) (map[int]*pb.PanErrorProto, error) {
+	v := newPansRequestValidator(supportedShapes, styles, supportedFlours)
+	v.validate(req)
+	if !req.Partial {
+		return nil, v.err(v.violations)
+	}
+
+	var requestViolations []*errdetails.BadRequest_FieldViolation
+	for i, violation := range v.violations {
+		if v.pans[i] < 0 {
+			requestViolations = append(requestViolations, violation)
+		}
+	}
+	if err := v.err(requestViolations); err != nil {
+		return nil, err
+	}
+	return v.panErrors(), nil
+}
+
+func (v *pansRequestValidator) validate(req *pb.PansRequest) {
+	v.strict = req.Strict
+
+	unit, err := domain.ParseUnit(req.Unit)
+	if err != nil {
+		v.add("unit", err.Error())
+		unit = domain.Centimeters
+	}
+	if req.Style != "" && !v.styles[normalizeName(req.Style)] {
+		v.add("style", fmt.Sprintf("unknown style: %s", req.Style))
+	}
+	if req.Fermentation != nil {
+		v.validateFermentation(req.Fermentation)
+	}
+	if req.Preferment != nil {
+		v.validatePreferment(req.Preferment)
+	}
+	if len(req.Blend) > 0 {
+		v.validateBlend(req.Blend)
+	}
+	if req.Formula != nil {
+		for _, percentage := range formulaRanges {
+			if value := percentage.value(req.Formula); value != nil && (!finite(*value) || *value < percentage.min || *value > percentage.max) {
+				v.add("formula."+percentage.name, fmt.Sprintf("must be between %g%% and %g%%", percentage.min, percentage.max))
+			}
+		}
+	}
+
+	if req.Pans == nil {
+		v.add("pans", "pans is required")
+		return
+	}
+	if req.Pans.ThicknessFactor != nil && (!finite(*req.Pans.ThicknessFactor) || *req.Pans.ThicknessFactor <= 0 || *req.Pans.ThicknessFactor > maxThicknessFactor) {
+		v.add("pans.thicknessFactor", fmt.Sprintf("must be greater than 0 and at most %g", maxThicknessFactor))
+	}
+	if req.Pans.RiseFactor != nil && (!finite(*req.Pans.RiseFactor) || *req.Pans.RiseFactor < 1 || *req.Pans.RiseFactor > maxRiseFactor) {
+		v.add("pans.riseFactor", fmt.Sprintf("must be between 1 and %g", maxRiseFactor))
+	}
+	if req.Pans.WasteFactor != nil && (!finite(*req.Pans.WasteFactor) || *req.Pans.WasteFactor < 1 || *req.Pans.WasteFactor > maxWasteFactor) {
+		v.add("pans.wasteFactor", fmt.Sprintf("must be between 1 and %g", maxWasteFactor))
+	}
+	if req.Pans.ScalePrecision != nil && (!finite(*req.Pans.ScalePrecision) || *req.Pans.ScalePrecision <= 0 || *req.Pans.ScalePrecision > maxScalePrecision) {
+		v.add("pans.scalePrecision", fmt.Sprintf("must be greater than 0 and at most %g", maxScalePrecision))
+	}
+	if len(req.Pans.Pans) > maxPansPerRequest {
+		v.add("pans.pans", fmt.Sprintf("at most %d pans are allowed per request, got %d", maxPansPerRequest, len(req.Pans.Pans)))
+		return
+	}
+
+	for i, pan := range req.Pans.Pans {
+		v.pan = i
+		v.validatePan(panPath(i), pan, unit)
+	}
+	v.pan = -1
+}
+
+func (v *pansRequestValidator) validateFermentation(fermentation *pb.FermentationProto) {
+	if !finite(fermentation.Hours) || fermentation.Hours < yeast.MinHours || fermentation.Hours > yeast.MaxHours {
+		v.add("fermentation.hours", fmt.Sprintf("must be between %g and %g", yeast.MinHours, yeast.MaxHours))
+	}
+	if !finite(fermentation.Temperature) || fermentation.Temperature < yeast.MinTemperature || fermentation.Temperature > yeast.MaxTemperature {
+		v.add("fermentation.temperature", fmt.Sprintf("must be between %g and %g °C", yeast.MinTemperature, yeast.MaxTemperature))
+	}
+	if _, err := domain.ParseYeastType(fermentation.YeastType); err != nil {
+		v.add("fermentation.yeastType", err.Error())
+	}
+}
+
+func (v *pansRequestValidator) validatePreferment(pre *pb.PrefermentProto) {
+	if _, err := domain.ParsePrefermentType(pre.Type); err != nil {
+		v.add("preferment.type", err.Error())
+	}
+	switch {
+	case pre.FlourShare != 0 && pre.Inoculation != 0:
+		v.add("preferment.inoculation", "cannot be set together with flourShare")
+	case pre.Inoculation != 0:
+		if !finite(pre.Inoculation) || pre.Inoculation < 0 {
+			v.add("preferment.inoculation", "must be positive")
+		}
+	case !finite(pre.FlourShare) || pre.FlourShare <= 0 || pre.FlourShare > 100:
+		v.add("preferment.flourShare", "must be greater than 0% and at most 100%")
+	}
+	if pre.Hydration != nil && (!finite(*pre.Hydration) || *pre.Hydration < preferment.MinHydration || *pre.Hydration > preferment.MaxHydration) {
+		v.add("preferment.hydration", fmt.Sprintf("must be between %g%% and %g%%", preferment.MinHydration, preferment.MaxHydration))
+	}
+}
+
+func (v *pansRequestValidator) validateBlend(blend []*pb.BlendPartProto) {
+	seen := make(map[string]bool, len(blend))
+	total := 0.0
+	for i, part := range blend {
+		field := fmt.Sprintf("blend[%d]", i)
+		id := normalizeName(part.GetFlour())
+		switch {
+		case id == "":
+			v.add(field+".flour", "flour is required")
+		case !v.flours[id]:
+			v.add(field+".flour", fmt.Sprintf("unknown flour: %s", part.GetFlour()))
+		case seen[id]:
+			v.add(field+".flour", fmt.Sprintf("%s appears more than once", part.GetFlour()))
+		}
+		seen[id] = true
+		if !finite(part.GetShare()) || part.GetShare() <= 0 || part.GetShare() > 100 {
+			v.add(field+".share", "must be greater than 0% and at most 100%")
+		}
+		total += part.GetShare()
+	}
+	if math.Abs(total-100) > flours.BlendTolerance {
+		v.add("blend", fmt.Sprintf("shares must sum to 100%%, got %g%%", total))
+	}
+}
+
+func (v *pansRequestValidator) validatePan(field string, pan *pb.PanProto, unit domain.Unit) {
+	if pan == nil {
+		v.add(field, "pan is required")
+		return
+	}
+	if pan.Quantity < 0 || pan.Quantity > maxPanQuantity {
+		v.add(field+".quantity", fmt.Sprintf("must be between 0 and %d", maxPanQuantity))
+	}
+	if pan.BallCount < 0 || pan.BallCount > maxBallsPerPan {
+		v.add(field+".ballCount", fmt.Sprintf("must be between 0 and %d", maxBallsPerPan))
+	}
+	if pan.CatalogId != "" {
+		// shape and measures come from the catalog preset
+		if v.strict && pan.Shape != "" {
+			v.add(field+".shape", "shape comes from catalog pan "+pan.CatalogId)
+		}
+		if v.strict && pan.Measures != nil {
+			v.add(field+".measures", "measures come from catalog pan "+pan.CatalogId)
+		}
+		return
+	}
+
+	shape, ok := v.shapes[normalizeName(pan.Shape)]
+	if pan.Shape == "" {
+		v.add(field+".shape", "shape is required")
+	} else if !ok {
+		v.add(field+".shape", fmt.Sprintf("unsupported shape: %s", pan.Shape))
+	}
+
+	if pan.Measures == nil {
+		v.add(field+".measures", "measures are required")
+		return
+	}
+
+	measures, err := toDomainMeasures(pan.Measures)
+	if err != nil {
+		v.add(field+".measures.unit", err.Error())
+		return
+	}
+	if measures.Unit != "" {
+		unit = measures.Unit
+	}
+
+	v.validateMeasures(field+".measures", measures, shape, ok, unit)
+}
+
+func (v *pansRequestValidator) validateMeasures(field string, measures domain.Measures, shape shapes.Shape, knownShape bool, unit domain.Unit) {
+	values := measures.Values()
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		value := values[name]
+		if !finite(value) {
+			v.add(field+"."+name, "must be a finite number")
+			continue
+		}
+		spec, ok := shape.Measure(name)
+		if !ok {
+			// measures the shape ignores are only checked for sanity; strict
+			// mode rejects them altogether
+			if value < 0 {
+				v.add(field+"."+name, "cannot be negative")
+			}
+			continue
+		}
+		spec = spec.In(unit)
+		if value < spec.Min || value > spec.Max {
+			v.add(field+"."+name, rangeMessage(spec, unit))
+		}
+	}
+
+	vertexSpec, polygon := shape.Measure("vertices")
+	if polygon {
+		vertexSpec = vertexSpec.In(unit)
+	}
+	for i, vertex := range measures.Vertices {
+		vertexField := fmt.Sprintf("%s.vertices[%d]", field, i)
+		switch {
+		case !finite(vertex.X) || !finite(vertex.Y):
+			v.add(vertexField, "must be a finite number")
+		case polygon && (vertex.X < vertexSpec.Min || vertex.X > vertexSpec.Max || vertex.Y < vertexSpec.Min || vertex.Y > vertexSpec.Max):
+			v.add(vertexField, rangeMessage(vertexSpec, unit))
+		}
+	}
+
+	if !knownShape {
+		return
+	}
+	if v.strict {
+		for _, violation := range shape.StrictViolations(measures) {
+			v.add(field+"."+violation.Measure, violation.Description)
+		}
+	}
+	for _, spec := range shape.Measures {
+		if !spec.Required {
+			continue
+		}
+		_, set := values[spec.Name]
+		if spec.Kind == shapes.PointsMeasure {
+			set = len(measures.Vertices) > 0
+		}
+		if !set {
+			v.add(field+"."+spec.Name, spec.Name+" is required")
+		}
+	}
+}
+
+func (v *pansRequestValidator) add(field, description string) {
+	v.violations = append(v.violations, &errdetails.BadRequest_FieldViolation{
+		Field:       field,
+		Description: description,
+	})
+	v.pans = append(v.pans, v.pan)
+}
+
+func (v *pansRequestValidator) err(violations []*errdetails.BadRequest_FieldViolation) error {
+	if len(violations) == 0 {
+		return nil
+	}
+
+	st := status.New(codes.InvalidArgument, fmt.Sprintf("invalid pans request: %d field violation(s)", len(violations)))
+	detailed, err := st.WithDetails(&errdetails.BadRequest{FieldViolations: violations})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+// panErrors groups the violations of each invalid pan, with field paths
+// relative to the pan.
+func (v *pansRequestValidator) panErrors() map[int]*pb.PanErrorProto {
+	panErrors := make(map[int]*pb.PanErrorProto)
+	for i, violation := range v.violations {
+		index := v.pans[i]
+		if index < 0 {
+			continue
+		}
+
+		panError, ok := panErrors[index]
+		if !ok {
+			panError = &pb.PanErrorProto{Code: codes.InvalidArgument.String()}
+			panErrors[index] = panError
+		}
+		field := strings.TrimPrefix(strings.TrimPrefix(violation.Field, panPath(index)), ".")
+		message := violation.Description
+		if field != "" {
+			message = field + ": " + message
+			panError.Fields = append(panError.Fields, field)
+		}
+		if panError.Message != "" {
+			message = panError.Message + "; " + message
+		}
+		panError.Message = message
+	}
+	return panErrors
+}
+
+func panPath(index int) string {
+	return fmt.Sprintf("pans.pans[%d]", index)
+}
+
+func rangeMessage(spec shapes.MeasureSpec, unit domain.Unit) string {
+	suffix := " " + string(unit)
+	if spec.Kind == shapes.CountMeasure {
+		suffix = ""
+	}
+	return fmt.Sprintf("must be between %s and %s%s", formatBound(spec.Min), formatBound(spec.Max), suffix)
+}
+
+// finite reports whether a double field holds a number; NaN would pass every
+// range check since it compares false with both bounds.
+func finite(value float64) bool {
+	return !math.IsNaN(value) && !math.IsInf(value, 0)
+}
+
+// normalizeName folds shape names and style IDs the way their registries
+// look them up.
+func normalizeName(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+func formatBound(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
@@ -0,0 +1,223 @@
+package shapes
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/cfioretti/calculator/pkg/domain"
+)
+
+// ErrUnsupportedShape is returned, wrapped with the shape name, for shapes
+// that are not registered.
+var ErrUnsupportedShape = domain.ErrUnsupportedShape
+
+// Shape describes a pan shape and the strategy that calculates it.
+type Shape struct {
+	Name        string
+	Aliases     []string
+	Measures    []MeasureSpec
+	Description string
+	Strategy    domain.PanStrategy
+}
+
+type MeasureKind string
+
+const (
+	LengthMeasure MeasureKind = "length"
+	CountMeasure  MeasureKind = "count"
+	PointsMeasure MeasureKind = "points"
+)
+
+// MeasureSpec describes one measure a shape reads. Length and point ranges
+// are expressed in centimetres.
+type MeasureSpec struct {
+	Name        string
+	Kind        MeasureKind
+	Required    bool
+	Min         float64
+	Max         float64
+	Description string
+	// ConflictsWith lists the measures that cannot be given together with
+	// this one.
+	ConflictsWith []string
+}
+
+// MeasureViolation is a measure rejected by strict validation.
+type MeasureViolation struct {
+	Measure     string
+	Description string
+}
+
+func (s Shape) RequiredMeasures() []string {
+	var names []string
+	for _, measure := range s.Measures {
+		if measure.Required {
+			names = append(names, measure.Name)
+		}
+	}
+	return names
+}
+
+// Measure returns the spec of the named measure, if the shape reads it.
+func (s Shape) Measure(name string) (MeasureSpec, bool) {
+	for _, measure := range s.Measures {
+		if measure.Name == name {
+			return measure, true
+		}
+	}
+	return MeasureSpec{}, false
+}
+
+// StrictViolations reports, sorted by measure name, the measures that are set
+// but not read by the shape and the ones set together with a measure they
+// conflict with.
+func (s Shape) StrictViolations(measures domain.Measures) []MeasureViolation {
+	set := measures.Values()
+	if len(measures.Vertices) > 0 {
+		set["vertices"] = float64(len(measures.Vertices))
+	}
+	names := make([]string, 0, len(set))
+	for name := range set {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var violations []MeasureViolation
+	for _, name := range names {
+		spec, ok := s.Measure(name)
+		if !ok {
+			violations = append(violations, MeasureViolation{
+				Measure:     name,
+				Description: fmt.Sprintf("%s is not a measure of shape %s", name, s.Name),
+			})
+			continue
+		}
+		for _, other := range spec.ConflictsWith {
+			if _, conflicting := set[other]; conflicting {
+				violations = append(violations, MeasureViolation{
+					Measure:     name,
+					Description: fmt.Sprintf("%s cannot be given together with %s", name, other),
+				})
+			}
+		}
+	}
+	return violations
+}
+
+// In converts the range of a length or points measure to the given unit.
+func (m MeasureSpec) In(unit domain.Unit) MeasureSpec {
+	if m.Kind == CountMeasure {
+		return m
+	}
+	m.Min = unit.FromCentimeters(m.Min)
+	m.Max = unit.FromCentimeters(m.Max)
+	return m
+}
+
+// Registry maps shape names and aliases to their strategies. It is safe for
+// concurrent use.
+type Registry struct {
+	mu      sync.RWMutex
+	shapes  map[string]Shape
+	aliases map[string]string
+}
+
+var defaultRegistry = NewRegistry()
+
+func NewRegistry() *Registry {
+	return &Registry{
+		shapes:  make(map[string]Shape),
+		aliases: make(map[string]string),
+	}
+}
+
+// Default returns the process-wide registry the built-in shapes register into.
+func Default() *Registry {
+	return defaultRegistry
+}
+
+func Register(shape Shape) error {
+	return defaultRegistry.Register(shape)
+}
+
+func MustRegister(shape Shape) {
+	defaultRegistry.MustRegister(shape)
+}
+
+func (r *Registry) Register(shape Shape) error {
+	name := normalize(shape.Name)
+	if name == "" {
+		return errors.New("shape name is required")
+	}
+	if shape.Strategy == nil {
+		return fmt.Errorf("shape %s has no strategy", name)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	keys := []string{name}
+	for _, alias := range shape.Aliases {
+		keys = append(keys, normalize(alias))
+	}
+	for _, key := range keys {
+		if _, exists := r.aliases[key]; exists {
+			return fmt.Errorf("shape %s is already registered", key)
+		}
+	}
+
+	shape.Name = name
+	r.shapes[name] = shape
+	for _, key := range keys {
+		r.aliases[key] = name
+	}
+	return nil
+}
+
+func (r *Registry) MustRegister(shape Shape) {
+	if err := r.Register(shape); err != nil {
+		panic(err)
+	}
+}
+
+// Lookup resolves a shape by name or alias, ignoring case.
+func (r *Registry) Lookup(name string) (Shape, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	canonical, ok := r.aliases[normalize(name)]
+	if !ok {
+		return Shape{}, fmt.Errorf("%w: %s", ErrUnsupportedShape, name)
+	}
+	return r.shapes[canonical], nil
+}
+
+func (r *Registry) Strategy(name string) (domain.PanStrategy, error) {
+	shape, err := r.Lookup(name)
+	if err != nil {
+		return nil, err
+	}
+	return shape.Strategy, nil
+}
+
+// Shapes returns every registered shape sorted by name.
+func (r *Registry) Shapes() []Shape {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	shapes := make([]Shape, 0, len(r.shapes))
+	for _, shape := range r.shapes {
+		shapes = append(shapes, shape)
+	}
+	sort.Slice(shapes, func(i, j int) bool {
+		return shapes[i].Name < shapes[j].Name
+	})
+	return shapes
+}
+
+func normalize(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
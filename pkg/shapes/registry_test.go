@@ -0,0 +1,206 @@
+package shapes
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cfioretti/calculator/pkg/domain"
+)
+
+type stubStrategy struct{}
+
+func (s *stubStrategy) Calculate(measures domain.Measures) (domain.Pan, error) {
+	return domain.Pan{Shape: "stub", Measures: measures, Area: 1}, nil
+}
+
+func TestRegistryRegister(t *testing.T) {
+	tests := []struct {
+		name    string
+		shapes  []Shape
+		wantErr bool
+	}{
+		{
+			name:   "single shape with aliases",
+			shapes: []Shape{{Name: "stub", Aliases: []string{"fake"}, Strategy: &stubStrategy{}}},
+		},
+		{
+			name:    "missing name",
+			shapes:  []Shape{{Strategy: &stubStrategy{}}},
+			wantErr: true,
+		},
+		{
+			name:    "missing strategy",
+			shapes:  []Shape{{Name: "stub"}},
+			wantErr: true,
+		},
+		{
+			name: "duplicate name",
+			shapes: []Shape{
+				{Name: "stub", Strategy: &stubStrategy{}},
+				{Name: "Stub", Strategy: &stubStrategy{}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "alias clashing with a name",
+			shapes: []Shape{
+				{Name: "stub", Strategy: &stubStrategy{}},
+				{Name: "other", Aliases: []string{"stub"}, Strategy: &stubStrategy{}},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			registry := NewRegistry()
+			var err error
+			for _, shape := range tt.shapes {
+				if err = registry.Register(shape); err != nil {
+					break
+				}
+			}
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestRegistryLookup(t *testing.T) {
+	registry := NewRegistry()
+	registry.MustRegister(Shape{Name: "stub", Aliases: []string{"fake"}, Strategy: &stubStrategy{}})
+
+	tests := []struct {
+		name    string
+		shape   string
+		wantErr bool
+	}{
+		{"by name", "stub", false},
+		{"by alias", "fake", false},
+		{"ignoring case", " FAKE ", false},
+		{"unknown shape", "star", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			strategy, err := registry.Strategy(tt.shape)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrUnsupportedShape)
+				assert.Nil(t, strategy)
+				return
+			}
+			assert.NoError(t, err)
+			assert.NotNil(t, strategy)
+		})
+	}
+}
+
+func TestRegistryShapesSorted(t *testing.T) {
+	registry := NewRegistry()
+	registry.MustRegister(Shape{Name: "zeta", Strategy: &stubStrategy{}})
+	registry.MustRegister(Shape{Name: "alpha", Strategy: &stubStrategy{}})
+
+	shapes := registry.Shapes()
+
+	require.Len(t, shapes, 2)
+	assert.Equal(t, "alpha", shapes[0].Name)
+	assert.Equal(t, "zeta", shapes[1].Name)
+}
+
+func TestRegistryConcurrentAccess(t *testing.T) {
+	registry := NewRegistry()
+	var wg sync.WaitGroup
+
+	for i := 0; i < 50; i++ {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			registry.MustRegister(Shape{Name: fmt.Sprintf("shape-%d", i), Strategy: &stubStrategy{}})
+		}(i)
+		go func() {
+			defer wg.Done()
+			registry.Shapes()
+			_, _ = registry.Lookup("shape-0")
+		}()
+	}
+	wg.Wait()
+
+	assert.Len(t, registry.Shapes(), 50)
+}
+
+func TestShapeMeasures(t *testing.T) {
+	shape := Shape{
+		Name: "stub",
+		Measures: []MeasureSpec{
+			{Name: "diameter", Kind: LengthMeasure, Required: true, Min: 5, Max: 254},
+			{Name: "sides", Kind: CountMeasure, Min: 3, Max: 64},
+		},
+		Strategy: &stubStrategy{},
+	}
+
+	assert.Equal(t, []string{"diameter"}, shape.RequiredMeasures())
+
+	diameter, ok := shape.Measure("diameter")
+	require.True(t, ok)
+	inches := diameter.In(domain.Inches)
+	assert.InDelta(t, 100, inches.Max, 0.0001)
+
+	sides, ok := shape.Measure("sides")
+	require.True(t, ok)
+	assert.Equal(t, sides, sides.In(domain.Inches))
+
+	_, ok = shape.Measure("edge")
+	assert.False(t, ok)
+}
+
+func TestShapeStrictViolations(t *testing.T) {
+	shape := Shape{
+		Name: "stub",
+		Measures: []MeasureSpec{
+			{Name: "edge", Kind: LengthMeasure, ConflictsWith: []string{"circumradius"}},
+			{Name: "circumradius", Kind: LengthMeasure, ConflictsWith: []string{"edge"}},
+		},
+		Strategy: &stubStrategy{},
+	}
+	value := 10.0
+
+	tests := []struct {
+		name     string
+		measures domain.Measures
+		want     []MeasureViolation
+	}{
+		{
+			name:     "Accepted measure",
+			measures: domain.Measures{Edge: &value},
+		},
+		{
+			name:     "Unexpected measures",
+			measures: domain.Measures{Edge: &value, Diameter: &value, Vertices: []domain.Point{{X: 0, Y: 0}}},
+			want: []MeasureViolation{
+				{Measure: "diameter", Description: "diameter is not a measure of shape stub"},
+				{Measure: "vertices", Description: "vertices is not a measure of shape stub"},
+			},
+		},
+		{
+			name:     "Conflicting measures",
+			measures: domain.Measures{Edge: &value, Circumradius: &value},
+			want: []MeasureViolation{
+				{Measure: "circumradius", Description: "circumradius cannot be given together with edge"},
+				{Measure: "edge", Description: "edge cannot be given together with circumradius"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, shape.StrictViolations(tt.measures))
+		})
+	}
+}